@@ -0,0 +1,18 @@
+# List the GitHub organizations whose name starts with "acme-"
+data "boostsecurity_collections" "acme" {
+  provider_name = "GitHub"
+  name_regex    = "^acme-"
+}
+
+# Fortify each of them
+resource "boostsecurity_fortify" "acme" {
+  for_each = { for collection in data.boostsecurity_collections.acme.collections : collection.name => collection }
+
+  asset = {
+    provider   = "GitHub"
+    collection = each.key
+    scanners = [
+      { id = "<scanner_id>" },
+    ]
+  }
+}
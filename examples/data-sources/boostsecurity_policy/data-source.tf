@@ -0,0 +1,13 @@
+# Resolve a policy by its display name
+data "boostsecurity_policy" "example" {
+  name = "<policy name>"
+}
+
+resource "boostsecurity_fortify" "example" {
+  asset = {
+    provider   = "GitHub"
+    collection = "<collection>"
+    policy     = data.boostsecurity_policy.example.id
+    scanners   = []
+  }
+}
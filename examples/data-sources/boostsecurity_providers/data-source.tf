@@ -0,0 +1,6 @@
+# List the connected providers
+data "boostsecurity_providers" "all" {}
+
+output "provider_names" {
+  value = [for provider in data.boostsecurity_providers.all.providers : provider.name]
+}
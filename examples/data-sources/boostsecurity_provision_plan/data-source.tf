@@ -0,0 +1,9 @@
+# List the scanners available on a collection
+data "boostsecurity_provision_plan" "example" {
+  asset_type = "COLLECTION"
+  asset_ids  = ["<collection_id>"]
+}
+
+output "available_scanners" {
+  value = [for scanner in data.boostsecurity_provision_plan.example.scanners : scanner.scanner_id if scanner.availability == "AVAILABLE"]
+}
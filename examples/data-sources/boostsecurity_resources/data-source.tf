@@ -0,0 +1,23 @@
+# List the repositories of an organization matching a search term
+data "boostsecurity_resources" "services" {
+  provider_name = "GitHub"
+  filters = {
+    collections = ["<collection>"]
+    search      = "<search term>"
+  }
+}
+
+# Fortify each of them. Drive for_each with filters the fortify resource does not change: with a filter such
+# as missing_coverages, the fortified resources stop matching and the next plan destroys them.
+resource "boostsecurity_fortify" "services" {
+  for_each = { for resource in data.boostsecurity_resources.services.resources : resource.resource_id => resource }
+
+  asset = {
+    provider   = "GitHub"
+    collection = each.value.collection
+    resource   = each.value.name
+    scanners = [
+      { id = "<scanner_id>" },
+    ]
+  }
+}
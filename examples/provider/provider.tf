@@ -1,5 +1,5 @@
 # Configuration-based authentication
 provider "boostsecurity" {
-  host  = "https://api.dev.boostsec.io/asset-management/graphql"
-  token = "<token>"
+  environment = "dev"
+  token       = "<token>"
 }
\ No newline at end of file
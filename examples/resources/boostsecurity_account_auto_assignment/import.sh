@@ -0,0 +1,2 @@
+# The account auto assignment is a singleton and is imported as "account".
+terraform import boostsecurity_account_auto_assignment.example account
@@ -0,0 +1,4 @@
+# Manage the analyzers auto-assigned to every new asset
+resource "boostsecurity_account_auto_assignment" "example" {
+  analyzer_ids = ["<analyzer_id>"]
+}
@@ -0,0 +1,2 @@
+# Analyzer auto assignments can be imported by specifying the provider name and the analyzer ID.
+terraform import boostsecurity_analyzer_auto_assignment.example "<provider_name>/<analyzer_id>"
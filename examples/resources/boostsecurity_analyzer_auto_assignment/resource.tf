@@ -0,0 +1,6 @@
+# Manage the auto assignment of an analyzer for a provider
+resource "boostsecurity_analyzer_auto_assignment" "example" {
+  provider_name = "<GitHub|GitLab|Azure DevOps|Bitbucket>"
+  analyzer_id   = "<analyzer_id>"
+  enabled       = true
+}
@@ -0,0 +1,5 @@
+# Resources can be imported by specifying the provider, the collection and the resource name.
+terraform import boostsecurity_fortify.example "GitHub/my-org/my-repo"
+
+# Collections can be imported by specifying the provider and the collection.
+terraform import boostsecurity_fortify.example "GitHub/my-org"
@@ -5,6 +5,12 @@ resource "boostsecurity_fortify" "example" {
     collection = "<Full path to up to the resource>"
     resource   = "<resource name>"
     policy     = "<policy_id>"
-    scanners   = ["<scanner_id>"]
+    scanners = [
+      {
+        id = "<scanner_id>"
+        # A ruleset cannot be applied by terraform, once the scanner runs with a ruleset selected in Boost
+        # it may be asserted with: ruleset = "<ruleset name>"
+      },
+    ]
   }
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Scanner configs can be imported by specifying the analyzer ID.
+terraform import boostsecurity_scanner_config.example "<analyzer_id>"
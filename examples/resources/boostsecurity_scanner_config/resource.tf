@@ -0,0 +1,7 @@
+# Manage the global configuration of a scanner
+resource "boostsecurity_scanner_config" "example" {
+  analyzer_id = "<analyzer_id>"
+  global_configs = {
+    "<config_id>" = "<value>"
+  }
+}
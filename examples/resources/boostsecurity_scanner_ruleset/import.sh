@@ -0,0 +1,2 @@
+# Scanner rulesets can be imported by specifying the analyzer ID and the ruleset UUID.
+terraform import boostsecurity_scanner_ruleset.example "<analyzer_id>/<ruleset_id>"
@@ -0,0 +1,8 @@
+# Manage a ruleset of a scanner
+resource "boostsecurity_scanner_ruleset" "example" {
+  analyzer_id = "<analyzer_id>"
+  name        = "<ruleset name>"
+  configs = {
+    "<config_id>" = "<value>"
+  }
+}
@@ -0,0 +1,76 @@
+package boostsecurity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// GetAccountAutoAssignment returns the IDs of the analyzers auto-assigned to every new asset.
+func (c *Client) GetAccountAutoAssignment(ctx context.Context) ([]string, error) {
+	result, err := AccountAutoAssignment(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account auto assignment %w", err)
+	}
+
+	analyzerIds := make([]string, 0)
+	for _, analyzer := range result.AccountAutoAssignment.Analyzers {
+		analyzerIds = append(analyzerIds, analyzer.Id)
+	}
+	sort.Strings(analyzerIds)
+
+	return analyzerIds, nil
+}
+
+// UpdateAccountAutoAssignment replaces the set of analyzers auto-assigned to every new asset.
+func (c *Client) UpdateAccountAutoAssignment(ctx context.Context, analyzerIds []string) error {
+	res, err := SetAccountAutoAssignment(ctx, *c.client, analyzerIds)
+	if err != nil {
+		return err
+	}
+
+	if response, ok := res.SetAccountAutoAssignment.(*SetAccountAutoAssignmentSetAccountAutoAssignmentOperationError); ok {
+		return newOperationError(response)
+	}
+
+	return nil
+}
+
+// GetAnalyzerAutoAssignment returns the auto assignment of an analyzer for a provider, or nil when the provider
+// does not offer auto assignment for that analyzer.
+func (c *Client) GetAnalyzerAutoAssignment(ctx context.Context, providerName string, analyzerId string) (*AutoAssignmentModel, error) {
+	result, err := SecurityPosture(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error in SecurityPosture %w", err)
+	}
+
+	for _, item := range result.SecurityPosture.Providers.Edges {
+		if item.Node.Name != providerName {
+			continue
+		}
+		for _, autoAssignment := range item.Node.AutoAssignment {
+			if autoAssignment.Id == analyzerId {
+				return &AutoAssignmentModel{ID: autoAssignment.Id, Enabled: autoAssignment.Enabled}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateAnalyzerAutoAssignment enables or disables the auto assignment of an analyzer.
+func (c *Client) UpdateAnalyzerAutoAssignment(ctx context.Context, analyzerId string, enabled bool) (*AutoAssignmentModel, error) {
+	res, err := SetAnalyzerAutoAssignment(ctx, *c.client, analyzerId, enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response := res.SetAnalyzerAutoAssignment.(type) {
+	case *SetAnalyzerAutoAssignmentSetAnalyzerAutoAssignment:
+		return &AutoAssignmentModel{ID: response.Id, Enabled: response.Enabled}, nil
+	case *SetAnalyzerAutoAssignmentSetAnalyzerAutoAssignmentOperationError:
+		return nil, newOperationError(response)
+	}
+
+	return nil, fmt.Errorf("unexpected response type %s", res.SetAnalyzerAutoAssignment.GetTypename())
+}
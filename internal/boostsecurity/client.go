@@ -2,19 +2,40 @@ package boostsecurity
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/Khan/genqlient/graphql"
-	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 	"net/http"
+	"sync"
+	"time"
 )
 
+// DefaultParallelism is the number of concurrent requests issued while loading the posture when none is configured.
+const DefaultParallelism = 4
+
 type Doer interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
 type Client struct {
-	client *graphql.Client
+	client      *graphql.Client
+	url         string
+	doer        Doer
+	pageSize    int
+	parallelism int
+	retry       retryPolicy
+	// limiter is shared by every request of the client, nil when requests are not rate limited.
+	limiter *rateLimiter
+	// httpClient sends the requests, once authenticated, rate limited and retried.
+	httpClient Doer
+
+	// requests bounds the number of concurrent requests issued while loading the posture.
+	requests *semaphore.Weighted
+
+	// scannerConfigMutex serializes the read-modify-write cycles on scanner configs.
+	scannerConfigMutex sync.Mutex
 }
 
 type Asset struct {
@@ -25,18 +46,113 @@ type Asset struct {
 
 type clientWithHeader struct {
 	client Doer
-	token  string
+	token  TokenSource
 }
 
 func (c *clientWithHeader) Do(req *http.Request) (*http.Response, error) {
-	req.Header.Add("Authorization", fmt.Sprintf("ApiKey %s", c.token))
+	token, err := c.token.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("ApiKey %s", token))
+
+	resp, err := c.client.Do(req)
+	cached, ok := c.token.(*cachedTokenSource)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !ok || req.GetBody == nil {
+		return resp, err
+	}
+
+	// The token may have been rotated, fetch it again and retry once with the new one.
+	cached.invalidate(token)
+	refreshed, err := c.token.Token(req.Context())
+	if err != nil {
+		tflog.Warn(req.Context(), "Could not refresh the API token", map[string]any{"error": err.Error()})
+		return resp, nil
+	}
+	if refreshed == token {
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+
+	retry := req.Clone(req.Context())
+	retry.Body, err = req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retry.Header.Set("Authorization", fmt.Sprintf("ApiKey %s", refreshed))
+
+	return c.client.Do(retry)
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithPageSize sets the number of items requested per page on paginated connections.
+func WithPageSize(pageSize int) Option {
+	return func(c *Client) {
+		c.pageSize = pageSize
+	}
+}
 
-	return c.client.Do(req)
+// WithParallelism sets the maximum number of concurrent requests issued while loading the posture.
+func WithParallelism(parallelism int) Option {
+	return func(c *Client) {
+		c.parallelism = parallelism
+	}
 }
 
-func NewClient(url string, token string) *Client {
-	client := graphql.NewClient(url, &clientWithHeader{client: http.DefaultClient, token: token})
-	return &Client{client: &client}
+// WithRetries sets how many times a request failing with a transient error is retried, and the bounds of the
+// wait between attempts.
+func WithRetries(maxRetries int, waitMin time.Duration, waitMax time.Duration) Option {
+	return func(c *Client) {
+		c.retry = retryPolicy{maxRetries: maxRetries, waitMin: waitMin, waitMax: waitMax}
+	}
+}
+
+// WithRateLimit limits the requests sent to the API to requestsPerSecond, allowing bursts of up to burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithHTTPClient sets the client sending the requests to the API, see NewHTTPClient.
+func WithHTTPClient(httpClient Doer) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+func NewClient(url string, token TokenSource, opts ...Option) *Client {
+	c := &Client{
+		pageSize:    DefaultPageSize,
+		parallelism: DefaultParallelism,
+		retry:       retryPolicy{maxRetries: DefaultMaxRetries, waitMin: DefaultRetryWaitMin, waitMax: DefaultRetryWaitMax},
+		httpClient:  http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	transport := c.httpClient
+	if c.limiter != nil {
+		transport = &rateLimitedDoer{client: transport, limiter: c.limiter}
+	}
+	doer := &retryDoer{client: transport, policy: c.retry}
+	c.url = url
+	c.doer = &clientWithHeader{client: doer, token: token}
+	client := graphql.NewClient(url, c.doer)
+	c.client = &client
+	c.requests = semaphore.NewWeighted(int64(c.parallelism))
+	return c
+}
+
+// limit runs query once a request slot is available, or returns the context error if it is cancelled first.
+func (c *Client) limit(ctx context.Context, query func() error) error {
+	if err := c.requests.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer c.requests.Release(1)
+	return query()
 }
 
 func (c *Client) ApplyPlan(ctx context.Context, assetId string, assetType AssetType, policyId string, applyScannerIds []string, clearScannerIds []string) error {
@@ -61,31 +177,65 @@ func (c *Client) ApplyPlan(ctx context.Context, assetId string, assetType AssetT
 		return err
 	}
 
-	if res.ApplyProvisionPlan.GetTypename() == "OperationError" {
-		response := res.ApplyProvisionPlan.(*ApplyProvisionPlanApplyProvisionPlanOperationError)
-		return errors.New(spew.Sdump(response))
+	if response, ok := res.ApplyProvisionPlan.(*ApplyProvisionPlanApplyProvisionPlanOperationError); ok {
+		return newOperationError(response)
 	}
 
 	return nil
 
 }
 
-func (c *Client) GetProvisionPlan(context context.Context, assetId string, assetType AssetType) ([]string, error) {
-	selection := make([]AssetSelection, 1)
-	selection[0] = AssetSelection{SelectionType: SelectionTypeAsset, AssetIds: []string{assetId}, AssetType: assetType}
-	res, err := ProvisionPlan(context, *c.client, selection)
+func (c *Client) GetProvisionPlan(context context.Context, assetId string, assetType AssetType) ([]ProvisionPlanScannerModel, error) {
+	plan, err := c.GetAssetsProvisionPlan(context, assetType, []string{assetId})
 	if err != nil {
 		return nil, err
 	}
-	scanners := make([]string, 0)
-	for _, scanner := range res.ProvisionPlan.Scanners {
+	scanners := make([]ProvisionPlanScannerModel, 0)
+	for _, scanner := range plan.Scanners {
 		if scanner.Availability == ProvisionPlanScannerAvailabilityAvailable {
-			scanners = append(scanners, scanner.ScannerId)
+			scanners = append(scanners, scanner)
 		}
 	}
 	return scanners, nil
 }
 
+// GetAssetsProvisionPlan returns the provision plan of a selection of assets, with every scanner whatever its
+// availability.
+func (c *Client) GetAssetsProvisionPlan(ctx context.Context, assetType AssetType, assetIds []string) (*ProvisionPlanModel, error) {
+	selection := make([]AssetSelection, 1)
+	selection[0] = AssetSelection{SelectionType: SelectionTypeAsset, AssetIds: assetIds, AssetType: assetType}
+	res, err := ProvisionPlan(ctx, *c.client, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := ProvisionPlanModel{
+		TotalSelectedCollections: res.ProvisionPlan.TotalSelectedCollections,
+		TotalSelectedResources:   res.ProvisionPlan.TotalSelectedResources,
+		Scanners:                 make([]ProvisionPlanScannerModel, 0),
+	}
+	for _, scanner := range res.ProvisionPlan.Scanners {
+		rulesets := make([]string, 0)
+		for _, ruleset := range scanner.Rulesets {
+			rulesets = append(rulesets, ruleset.Name)
+		}
+		plan.Scanners = append(plan.Scanners, ProvisionPlanScannerModel{
+			ID:                         scanner.ScannerId,
+			Name:                       scanner.ScannerName,
+			Categories:                 scanner.Categories,
+			Availability:               scanner.Availability,
+			Targets:                    scanner.Targets,
+			TotalApplicableCollections: scanner.TotalApplicableCollections,
+			TotalApplicableResources:   scanner.TotalApplicableResources,
+			RulesetRequired:            scanner.RulesetRequired,
+			Rulesets:                   rulesets,
+		})
+	}
+	return &plan, nil
+}
+
+// GetPosture loads every provider with its collections and resources. Collections and resources are
+// fetched concurrently, bounded by the client parallelism; the first error cancels the remaining requests.
 func (c *Client) GetPosture(ctx context.Context) (*ProvidersModel, error) {
 	var data = ProvidersModel{}
 	result, err := SecurityPosture(ctx, *c.client)
@@ -93,81 +243,172 @@ func (c *Client) GetPosture(ctx context.Context) (*ProvidersModel, error) {
 		return nil, fmt.Errorf("error in SecurityPosture %w", err)
 	}
 
-	data.Providers = make([]ProviderModel, 0)
-	for _, item := range result.SecurityPosture.Providers.Edges {
-		node := item.Node
-		var organization []OrganizationModel
-		organization, err = c.getProviderCollections(ctx, node.ProviderId)
-		if err != nil {
-			return nil, fmt.Errorf("error in getProviderCollections %w", err)
-		}
-		provider := ProviderModel{
-			Name:          node.Name,
-			ID:            node.ProviderId,
-			Organizations: organization,
-		}
-
-		data.Providers = append(data.Providers, provider)
+	if result.SecurityPosture.Providers.PageInfo.HasNextPage {
+		// The providers connection does not accept pagination arguments.
+		tflog.Warn(ctx, "Providers are paginated by the API, only the first page is loaded", map[string]any{
+			"total_count": result.SecurityPosture.Providers.TotalCount,
+		})
+	}
 
+	data.Providers = make([]ProviderModel, len(result.SecurityPosture.Providers.Edges))
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i, item := range result.SecurityPosture.Providers.Edges {
+		i, node := i, item.Node
+		data.Providers[i] = ProviderModel{
+			Name: node.Name,
+			ID:   node.ProviderId,
+		}
+		group.Go(func() error {
+			organizations, err := c.getProviderCollections(groupCtx, node.ProviderId, nil)
+			if err != nil {
+				return fmt.Errorf("error in getProviderCollections %w", err)
+			}
+			data.Providers[i].Organizations = organizations
+			return nil
+		})
+	}
+	if err = group.Wait(); err != nil {
+		return nil, err
 	}
+
 	return &data, nil
 }
 
-func (c *Client) getProviderCollections(ctx context.Context, providerId string) ([]OrganizationModel, error) {
-	result, err := ProviderCollections(ctx, *c.client, providerId, 100)
+func (c *Client) getProviderCollections(ctx context.Context, providerId string, filters *Filters) ([]OrganizationModel, error) {
+	organizations, err := c.listCollections(ctx, providerId, filters)
 	if err != nil {
-		return nil, fmt.Errorf("error getting provider collections %w", err)
+		return nil, err
 	}
 
-	organizations := make([]OrganizationModel, 0)
-	for _, collection := range result.Provider.Collections.Edges {
-		node := collection.Node
-		scanners := make([]string, 0)
-		for _, s := range node.Scanners {
-			if s.State == ProvisioningStateProvisioned {
-				scanners = append(scanners, s.ScannerId)
+	// Each goroutine only writes to its own collection, the slice is not resized anymore.
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i := range organizations {
+		i := i
+		group.Go(func() error {
+			resources, err := c.getCollection(groupCtx, providerId, organizations[i].ID, filters)
+			if err != nil {
+				return fmt.Errorf("error getting collection %w", err)
 			}
+			organizations[i].Resources = resources
+			return nil
+		})
+	}
+	if err = group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return organizations, nil
+}
+
+// ListResources returns the collections of a provider with their resources matching filters. Collections without
+// any matching resource are left out.
+func (c *Client) ListResources(ctx context.Context, providerId string, filters Filters) ([]OrganizationModel, error) {
+	organizations, err := c.getProviderCollections(ctx, providerId, &filters)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]OrganizationModel, 0)
+	for _, organization := range organizations {
+		if len(organization.Resources) > 0 {
+			matching = append(matching, organization)
 		}
+	}
+
+	return matching, nil
+}
 
-		var resources []ResourcesModel
-		resources, err = c.getCollection(ctx, providerId, node.CollectionId)
+// ListCollections returns every collection of a provider, without their resources. When search is not empty,
+// only the collections matching it are returned.
+func (c *Client) ListCollections(ctx context.Context, providerId string, search string) ([]OrganizationModel, error) {
+	var filters *Filters
+	if search != "" {
+		filters = &Filters{Search: search}
+	}
+
+	return c.listCollections(ctx, providerId, filters)
+}
+
+func (c *Client) listCollections(ctx context.Context, providerId string, filters *Filters) ([]OrganizationModel, error) {
+	organizations := make([]OrganizationModel, 0)
+	err := paginate(func(after string) (*ConnectionDataPageInfo, error) {
+		var result *ProviderCollectionsResponse
+		err := c.limit(ctx, func() (err error) {
+			result, err = ProviderCollections(ctx, *c.client, providerId, filters, c.pageSize, after)
+			return err
+		})
 		if err != nil {
-			return nil, fmt.Errorf("error getting collection %w", err)
+			return nil, fmt.Errorf("error getting provider collections %w", err)
 		}
-		organizations = append(organizations, OrganizationModel{
-			Name:      node.Name,
-			ID:        node.CollectionId,
-			Scanners:  scanners,
-			Policy:    node.Policy.PolicyId,
-			Resources: resources,
-		})
+
+		for _, collection := range result.Provider.Collections.Edges {
+			node := collection.Node
+			organizations = append(organizations, OrganizationModel{
+				Name:             node.Name,
+				ID:               node.CollectionId,
+				AssetType:        node.AssetType,
+				BaseURL:          node.BaseUrl,
+				WebURL:           node.WebUrl,
+				IconURL:          node.IconUrl,
+				Scanners:         toProvisionedScanners(node.Scanners),
+				Policy:           node.Policy.PolicyId,
+				PolicyAssignment: node.Policy.Assignment,
+			})
+		}
+
+		return &result.Provider.Collections.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return organizations, nil
 }
 
-func (c *Client) getCollection(ctx context.Context, providerId string, collectionId string) ([]ResourcesModel, error) {
-	result, err := ProviderCollection(ctx, *c.client, providerId, collectionId, 100)
+func (c *Client) getCollection(ctx context.Context, providerId string, collectionId string, filters *Filters) ([]ResourcesModel, error) {
+	resources := make([]ResourcesModel, 0)
+	err := paginate(func(after string) (*ConnectionDataPageInfo, error) {
+		var result *ProviderCollectionResponse
+		err := c.limit(ctx, func() (err error) {
+			result, err = ProviderCollection(ctx, *c.client, providerId, collectionId, filters, c.pageSize, after)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting collection resources %w", err)
+		}
+
+		for _, rcs := range result.Provider.Collection.Resources.Edges {
+			node := rcs.Node
+			resources = append(resources, ResourcesModel{
+				Name:             node.Name,
+				ID:               node.ResourceId,
+				Scanners:         toProvisionedScanners(node.Scanners),
+				Policy:           node.Policy.PolicyId,
+				PolicyAssignment: node.Policy.Assignment,
+			})
+		}
+
+		return &result.Provider.Collection.Resources.PageInfo, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error getting collection resources %w", err)
+		return nil, err
 	}
 
-	resources := make([]ResourcesModel, 0)
-	for _, rcs := range result.Provider.Collection.Resources.Edges {
-		node := rcs.Node
-		scanners := make([]string, 0)
-		for _, s := range node.Scanners {
-			if s.State == ProvisioningStateProvisioned {
-				scanners = append(scanners, s.ScannerId)
-			}
+	return resources, nil
+}
+
+// toProvisionedScanners keeps the scanners provisioned on an asset.
+func toProvisionedScanners(scanners []ScannerDataScannersScanner) []ProvisionedScannerModel {
+	provisioned := make([]ProvisionedScannerModel, 0)
+	for _, s := range scanners {
+		if s.State == ProvisioningStateProvisioned {
+			provisioned = append(provisioned, ProvisionedScannerModel{
+				ID:                 s.ScannerId,
+				Ruleset:            s.Ruleset.Name,
+				ProvisioningMethod: s.ProvisioningMethod,
+			})
 		}
-		resources = append(resources, ResourcesModel{
-			Name:     node.Name,
-			ID:       node.ResourceId,
-			Scanners: scanners,
-			Policy:   node.Policy.PolicyId,
-		})
 	}
 
-	return resources, nil
+	return provisioned
 }
@@ -0,0 +1,115 @@
+package boostsecurity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Khan/genqlient/graphql"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// CompatibilityError lists the fields and types the provider relies on that the API does not provide.
+type CompatibilityError struct {
+	Missing []string
+}
+
+func (e *CompatibilityError) Error() string {
+	return "the API is missing: " + strings.Join(e.Missing, ", ")
+}
+
+var (
+	unknownFieldPattern    = regexp.MustCompile(`Cannot query field "(\w+)" on type "(\w+)"`)
+	unknownTypePattern     = regexp.MustCompile(`Unknown type "(\w+)"`)
+	unknownArgumentPattern = regexp.MustCompile(`Unknown argument "(\w+)" on field "([\w.]+)"`)
+)
+
+// compatibilityChecks caches the outcome of CheckCompatibility by endpoint, as a *CompatibilityError that is nil
+// when the API is compatible. It is shared by every client, so that provider aliases and instances configured
+// with the same host probe it once per run.
+var compatibilityChecks sync.Map
+
+// CheckCompatibility runs the ExternalDataValidation query, which selects every field the provider relies on.
+// It returns a CompatibilityError when the API positively reports missing fields or types, or returns data of another
+// shape. Any other failure, such as a network error, is returned as is and is not cached.
+func (c *Client) CheckCompatibility(ctx context.Context) error {
+	if result, ok := compatibilityChecks.Load(c.url); ok {
+		if incompatible := result.(*CompatibilityError); incompatible != nil {
+			return incompatible
+		}
+		return nil
+	}
+
+	incompatible, err := c.probe(ctx)
+	if err != nil {
+		return err
+	}
+	compatibilityChecks.Store(c.url, incompatible)
+	if incompatible == nil {
+		return nil
+	}
+
+	return incompatible
+}
+
+// probe sends the query directly rather than through the generated function, validation errors may come with
+// a status other than 200 and the body is needed to report them.
+func (c *Client) probe(ctx context.Context) (*CompatibilityError, error) {
+	body, err := json.Marshal(&graphql.Request{
+		OpName: "ExternalDataValidation",
+		Query:  ExternalDataValidation_Operation,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var data ExternalDataValidationResponse
+	response := graphql.Response{Data: &data}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return &CompatibilityError{Missing: []string{fmt.Sprintf("%s as %s (got %s)", typeErr.Field, typeErr.Type, typeErr.Value)}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unexpected response %s: %w", resp.Status, err)
+	}
+
+	if len(response.Errors) == 0 {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected response %s", resp.Status)
+		}
+		return nil, nil
+	}
+
+	missing := make([]string, 0)
+	for _, gqlErr := range response.Errors {
+		if match := unknownFieldPattern.FindStringSubmatch(gqlErr.Message); match != nil {
+			missing = append(missing, match[2]+"."+match[1])
+		} else if match := unknownTypePattern.FindStringSubmatch(gqlErr.Message); match != nil {
+			missing = append(missing, match[1])
+		} else if match := unknownArgumentPattern.FindStringSubmatch(gqlErr.Message); match != nil {
+			missing = append(missing, match[2]+"("+match[1]+")")
+		}
+	}
+	if len(missing) == 0 {
+		// Not a recognized schema mismatch, authentication errors for instance.
+		return nil, response.Errors
+	}
+
+	return &CompatibilityError{Missing: missing}, nil
+}
@@ -0,0 +1,58 @@
+package boostsecurity
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckCompatibilityCachedPerHost(t *testing.T) {
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Cannot query field \"policy\" on type \"Resource\"."}]}`))
+	}))
+	defer server.Close()
+
+	// Each provider configure builds its own client, the outcome is shared by the clients of a host.
+	for i := 0; i < 2; i++ {
+		err := NewClient(server.URL, StaticToken("token"), WithRetries(0, 0, 0)).CheckCompatibility(context.Background())
+		var incompatible *CompatibilityError
+		if !errors.As(err, &incompatible) || len(incompatible.Missing) != 1 || incompatible.Missing[0] != "Resource.policy" {
+			t.Fatalf("got error %v, want Resource.policy missing", err)
+		}
+	}
+	if probes.Load() != 1 {
+		t.Errorf("got %d probes, want the host probed once", probes.Load())
+	}
+}
+
+func TestCheckCompatibilityTransientFailure(t *testing.T) {
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if probes.Add(1) == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, StaticToken("token"), WithRetries(0, 0, 0))
+	err := client.CheckCompatibility(context.Background())
+	var incompatible *CompatibilityError
+	if err == nil || errors.As(err, &incompatible) {
+		t.Fatalf("got error %v, want a failure that is not an incompatibility", err)
+	}
+
+	// The failure is not cached, the next check probes again.
+	if err := client.CheckCompatibility(context.Background()); err != nil {
+		t.Errorf("got error %v, want the API compatible", err)
+	}
+	if probes.Load() != 2 {
+		t.Errorf("got %d probes, want 2", probes.Load())
+	}
+}
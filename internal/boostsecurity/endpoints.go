@@ -0,0 +1,29 @@
+package boostsecurity
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Environments maps the Boost environments to their asset management endpoint. Other environments are reached
+// by configuring their endpoint as host.
+var Environments = map[string]string{
+	"dev": "https://api.dev.boostsec.io/asset-management/graphql",
+}
+
+// ValidateEndpoint checks that endpoint is an absolute HTTPS URL to a GraphQL API.
+func ValidateEndpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" || parsed.Host == "" {
+		return errors.New("the endpoint must be an absolute https URL")
+	}
+	if !strings.HasSuffix(strings.TrimSuffix(parsed.Path, "/"), "/graphql") {
+		return errors.New("the endpoint path must end with /graphql")
+	}
+
+	return nil
+}
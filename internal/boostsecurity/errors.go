@@ -0,0 +1,23 @@
+package boostsecurity
+
+import "fmt"
+
+// OperationError is returned by the mutations rejected by the API.
+type OperationError struct {
+	ErrorType    string
+	ErrorMessage string
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.ErrorType, e.ErrorMessage)
+}
+
+// operationError is implemented by the OperationError member of every mutation result union.
+type operationError interface {
+	GetErrorType() string
+	GetErrorMessage() string
+}
+
+func newOperationError(response operationError) *OperationError {
+	return &OperationError{ErrorType: response.GetErrorType(), ErrorMessage: response.GetErrorMessage()}
+}
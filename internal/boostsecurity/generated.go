@@ -1628,7 +1628,7 @@ type Filters struct {
 	PolicyType                     []string `json:"policyType"`
 	Policy                         []string `json:"policy"`
 	ProvisionedAnalyzers           []string `json:"provisionedAnalyzers"`
-	Search                         string   `json:"search"`
+	Search                         string   `json:"search,omitempty"`
 }
 
 // GetCollectionProvisioningStatuses returns Filters.CollectionProvisioningStatuses, and is useful for accessing the field via an interface.
@@ -1667,6 +1667,382 @@ const (
 	OperationActionClear OperationAction = "CLEAR"
 )
 
+// PoliciesResponse is returned by Policies on success.
+type PoliciesResponse struct {
+	SecurityPosture PoliciesSecurityPosture `json:"securityPosture"`
+}
+
+// GetSecurityPosture returns PoliciesResponse.SecurityPosture, and is useful for accessing the field via an interface.
+func (v *PoliciesResponse) GetSecurityPosture() PoliciesSecurityPosture { return v.SecurityPosture }
+
+// PoliciesSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
+type PoliciesSecurityPosture struct {
+	Filters PoliciesSecurityPostureFiltersAvailableFilters `json:"filters"`
+}
+
+// GetFilters returns PoliciesSecurityPosture.Filters, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPosture) GetFilters() PoliciesSecurityPostureFiltersAvailableFilters {
+	return v.Filters
+}
+
+// PoliciesSecurityPostureFiltersAvailableFilters includes the requested fields of the GraphQL type AvailableFilters.
+type PoliciesSecurityPostureFiltersAvailableFilters struct {
+	Policy []PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay `json:"policy"`
+}
+
+// GetPolicy returns PoliciesSecurityPostureFiltersAvailableFilters.Policy, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPostureFiltersAvailableFilters) GetPolicy() []PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay {
+	return v.Policy
+}
+
+// PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay includes the requested fields of the GraphQL type PolicyFilterDisplayFilterCountWithDisplay.
+type PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay struct {
+	FilterDataPolicyFilterDisplayFilterCountWithDisplay `json:"-"`
+	Display                                             PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay `json:"display"`
+}
+
+// GetDisplay returns PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay.Display, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) GetDisplay() PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay {
+	return v.Display
+}
+
+// GetValue returns PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay.Value, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) GetValue() string {
+	return v.FilterDataPolicyFilterDisplayFilterCountWithDisplay.Value
+}
+
+// GetCount returns PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay.Count, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) GetCount() int {
+	return v.FilterDataPolicyFilterDisplayFilterCountWithDisplay.Count
+}
+
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.FilterDataPolicyFilterDisplayFilterCountWithDisplay)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay struct {
+	Display PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay `json:"display"`
+
+	Value string `json:"value"`
+
+	Count int `json:"count"`
+}
+
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay) __premarshalJSON() (*__premarshalPoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay, error) {
+	var retval __premarshalPoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay
+
+	retval.Display = v.Display
+	retval.Value = v.FilterDataPolicyFilterDisplayFilterCountWithDisplay.Value
+	retval.Count = v.FilterDataPolicyFilterDisplayFilterCountWithDisplay.Count
+	return &retval, nil
+}
+
+// PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay includes the requested fields of the GraphQL type PolicyFilterDisplay.
+type PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay struct {
+	Name string `json:"name"`
+}
+
+// GetName returns PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay.Name, and is useful for accessing the field via an interface.
+func (v *PoliciesSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplayDisplayPolicyFilterDisplay) GetName() string {
+	return v.Name
+}
+
+// PolicyAssetsResponse is returned by PolicyAssets on success.
+type PolicyAssetsResponse struct {
+	SecurityPosture PolicyAssetsSecurityPosture `json:"securityPosture"`
+}
+
+// GetSecurityPosture returns PolicyAssetsResponse.SecurityPosture, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsResponse) GetSecurityPosture() PolicyAssetsSecurityPosture {
+	return v.SecurityPosture
+}
+
+// PolicyAssetsSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
+type PolicyAssetsSecurityPosture struct {
+	Account   PolicyAssetsSecurityPostureAccount                      `json:"account"`
+	Providers PolicyAssetsSecurityPostureProvidersProvidersConnection `json:"providers"`
+}
+
+// GetAccount returns PolicyAssetsSecurityPosture.Account, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPosture) GetAccount() PolicyAssetsSecurityPostureAccount {
+	return v.Account
+}
+
+// GetProviders returns PolicyAssetsSecurityPosture.Providers, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPosture) GetProviders() PolicyAssetsSecurityPostureProvidersProvidersConnection {
+	return v.Providers
+}
+
+// PolicyAssetsSecurityPostureAccount includes the requested fields of the GraphQL type Account.
+type PolicyAssetsSecurityPostureAccount struct {
+	PolicyDataAccount `json:"-"`
+}
+
+// GetPolicy returns PolicyAssetsSecurityPostureAccount.Policy, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureAccount) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataAccount.Policy
+}
+
+func (v *PolicyAssetsSecurityPostureAccount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PolicyAssetsSecurityPostureAccount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PolicyAssetsSecurityPostureAccount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataAccount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPolicyAssetsSecurityPostureAccount struct {
+	Policy PolicyDataPolicy `json:"policy"`
+}
+
+func (v *PolicyAssetsSecurityPostureAccount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PolicyAssetsSecurityPostureAccount) __premarshalJSON() (*__premarshalPolicyAssetsSecurityPostureAccount, error) {
+	var retval __premarshalPolicyAssetsSecurityPostureAccount
+
+	retval.Policy = v.PolicyDataAccount.Policy
+	return &retval, nil
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnection includes the requested fields of the GraphQL type ProvidersConnection.
+type PolicyAssetsSecurityPostureProvidersProvidersConnection struct {
+	Edges []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge `json:"edges"`
+}
+
+// GetEdges returns PolicyAssetsSecurityPostureProvidersProvidersConnection.Edges, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnection) GetEdges() []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge {
+	return v.Edges
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge includes the requested fields of the GraphQL type ProviderEdge.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge struct {
+	Node PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider `json:"node"`
+}
+
+// GetNode returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge.Node, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdge) GetNode() PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider {
+	return v.Node
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider includes the requested fields of the GraphQL type Provider.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider struct {
+	Collections PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection `json:"collections"`
+}
+
+// GetCollections returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider.Collections, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider) GetCollections() PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection {
+	return v.Collections
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection includes the requested fields of the GraphQL type CollectionsConnection.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection struct {
+	Edges []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge `json:"edges"`
+}
+
+// GetEdges returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnection) GetEdges() []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge {
+	return v.Edges
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge includes the requested fields of the GraphQL type CollectionEdge.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge struct {
+	Node PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection `json:"node"`
+}
+
+// GetNode returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdge) GetNode() PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection {
+	return v.Node
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection includes the requested fields of the GraphQL type Collection.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection struct {
+	PolicyDataCollection `json:"-"`
+	Resources            PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection `json:"resources"`
+}
+
+// GetResources returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.Resources, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetResources() PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection {
+	return v.Resources
+}
+
+// GetPolicy returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.Policy, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataCollection.Policy
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataCollection)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection struct {
+	Resources PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection `json:"resources"`
+
+	Policy PolicyDataPolicy `json:"policy"`
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) __premarshalJSON() (*__premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection, error) {
+	var retval __premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection
+
+	retval.Resources = v.Resources
+	retval.Policy = v.PolicyDataCollection.Policy
+	return &retval, nil
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection includes the requested fields of the GraphQL type ResourcesConnection.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection struct {
+	Edges []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge `json:"edges"`
+}
+
+// GetEdges returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnection) GetEdges() []PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge {
+	return v.Edges
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge includes the requested fields of the GraphQL type ResourceEdge.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge struct {
+	Node PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource `json:"node"`
+}
+
+// GetNode returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge.Node, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdge) GetNode() PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource {
+	return v.Node
+}
+
+// PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource includes the requested fields of the GraphQL type Resource.
+type PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource struct {
+	PolicyDataResource `json:"-"`
+}
+
+// GetPolicy returns PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.Policy, and is useful for accessing the field via an interface.
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataResource.Policy
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataResource)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource struct {
+	Policy PolicyDataPolicy `json:"policy"`
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) __premarshalJSON() (*__premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource, error) {
+	var retval __premarshalPolicyAssetsSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource
+
+	retval.Policy = v.PolicyDataResource.Policy
+	return &retval, nil
+}
+
 type PolicyAssignment string
 
 const (
@@ -2505,9 +2881,130 @@ type ProviderResponse struct {
 // GetProvider returns ProviderResponse.Provider, and is useful for accessing the field via an interface.
 func (v *ProviderResponse) GetProvider() ProviderProvider { return v.Provider }
 
-// ProvisionPlanProvisionPlan includes the requested fields of the GraphQL type ProvisionPlan.
-type ProvisionPlanProvisionPlan struct {
-	TotalSelectedCollections int                                                      `json:"totalSelectedCollections"`
+// ProvidersResponse is returned by Providers on success.
+type ProvidersResponse struct {
+	SecurityPosture ProvidersSecurityPosture `json:"securityPosture"`
+}
+
+// GetSecurityPosture returns ProvidersResponse.SecurityPosture, and is useful for accessing the field via an interface.
+func (v *ProvidersResponse) GetSecurityPosture() ProvidersSecurityPosture { return v.SecurityPosture }
+
+// ProvidersSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
+type ProvidersSecurityPosture struct {
+	Providers ProvidersSecurityPostureProvidersProvidersConnection `json:"providers"`
+}
+
+// GetProviders returns ProvidersSecurityPosture.Providers, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPosture) GetProviders() ProvidersSecurityPostureProvidersProvidersConnection {
+	return v.Providers
+}
+
+// ProvidersSecurityPostureProvidersProvidersConnection includes the requested fields of the GraphQL type ProvidersConnection.
+type ProvidersSecurityPostureProvidersProvidersConnection struct {
+	ConnectionDataProvidersConnection `json:"-"`
+	Edges                             []ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge `json:"edges"`
+}
+
+// GetEdges returns ProvidersSecurityPostureProvidersProvidersConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) GetEdges() []ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge {
+	return v.Edges
+}
+
+// GetTotalCount returns ProvidersSecurityPostureProvidersProvidersConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) GetTotalCount() int {
+	return v.ConnectionDataProvidersConnection.TotalCount
+}
+
+// GetPageInfo returns ProvidersSecurityPostureProvidersProvidersConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) GetPageInfo() ConnectionDataPageInfo {
+	return v.ConnectionDataProvidersConnection.PageInfo
+}
+
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProvidersSecurityPostureProvidersProvidersConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProvidersSecurityPostureProvidersProvidersConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ConnectionDataProvidersConnection)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalProvidersSecurityPostureProvidersProvidersConnection struct {
+	Edges []ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge `json:"edges"`
+
+	TotalCount int `json:"totalCount"`
+
+	PageInfo ConnectionDataPageInfo `json:"pageInfo"`
+}
+
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProvidersSecurityPostureProvidersProvidersConnection) __premarshalJSON() (*__premarshalProvidersSecurityPostureProvidersProvidersConnection, error) {
+	var retval __premarshalProvidersSecurityPostureProvidersProvidersConnection
+
+	retval.Edges = v.Edges
+	retval.TotalCount = v.ConnectionDataProvidersConnection.TotalCount
+	retval.PageInfo = v.ConnectionDataProvidersConnection.PageInfo
+	return &retval, nil
+}
+
+// ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge includes the requested fields of the GraphQL type ProviderEdge.
+type ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge struct {
+	Cursor string                                                                            `json:"cursor"`
+	Node   ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider `json:"node"`
+}
+
+// GetCursor returns ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge.Cursor, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge) GetCursor() string {
+	return v.Cursor
+}
+
+// GetNode returns ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge.Node, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdge) GetNode() ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider {
+	return v.Node
+}
+
+// ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider includes the requested fields of the GraphQL type Provider.
+type ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider struct {
+	ProviderId string `json:"providerId"`
+	Name       string `json:"name"`
+}
+
+// GetProviderId returns ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider.ProviderId, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider) GetProviderId() string {
+	return v.ProviderId
+}
+
+// GetName returns ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider.Name, and is useful for accessing the field via an interface.
+func (v *ProvidersSecurityPostureProvidersProvidersConnectionEdgesProviderEdgeNodeProvider) GetName() string {
+	return v.Name
+}
+
+// ProvisionPlanProvisionPlan includes the requested fields of the GraphQL type ProvisionPlan.
+type ProvisionPlanProvisionPlan struct {
+	TotalSelectedCollections int                                                      `json:"totalSelectedCollections"`
 	TotalSelectedResources   int                                                      `json:"totalSelectedResources"`
 	Scanners                 []ProvisionPlanProvisionPlanScannersProvisionPlanScanner `json:"scanners"`
 }
@@ -2831,13 +3328,13 @@ func (v *ScannerConfigMappingInput) GetId() string { return v.Id }
 func (v *ScannerConfigMappingInput) GetValue() string { return v.Value }
 
 type ScannerConfigRulesetInput struct {
-	Id      uuid.UUID                   `json:"id"`
+	Id      *uuid.UUID                  `json:"id,omitempty"`
 	Name    string                      `json:"name"`
 	Configs []ScannerConfigMappingInput `json:"configs"`
 }
 
 // GetId returns ScannerConfigRulesetInput.Id, and is useful for accessing the field via an interface.
-func (v *ScannerConfigRulesetInput) GetId() uuid.UUID { return v.Id }
+func (v *ScannerConfigRulesetInput) GetId() *uuid.UUID { return v.Id }
 
 // GetName returns ScannerConfigRulesetInput.Name, and is useful for accessing the field via an interface.
 func (v *ScannerConfigRulesetInput) GetName() string { return v.Name }
@@ -3150,66 +3647,48 @@ func (v *ScannerOperation) GetAction() OperationAction { return v.Action }
 // GetScannerId returns ScannerOperation.ScannerId, and is useful for accessing the field via an interface.
 func (v *ScannerOperation) GetScannerId() string { return v.ScannerId }
 
-type SecurityCategory string
-
-const (
-	SecurityCategoryCicd    SecurityCategory = "CICD"
-	SecurityCategoryIac     SecurityCategory = "IAC"
-	SecurityCategorySast    SecurityCategory = "SAST"
-	SecurityCategorySbom    SecurityCategory = "SBOM"
-	SecurityCategorySca     SecurityCategory = "SCA"
-	SecurityCategorySci     SecurityCategory = "SCI"
-	SecurityCategorySecrets SecurityCategory = "SECRETS"
-	SecurityCategoryLicense SecurityCategory = "LICENSE"
-)
-
-// SecurityPostureOnlyAccountResponse is returned by SecurityPostureOnlyAccount on success.
-type SecurityPostureOnlyAccountResponse struct {
-	SecurityPosture SecurityPostureOnlyAccountSecurityPosture `json:"securityPosture"`
-}
-
-// GetSecurityPosture returns SecurityPostureOnlyAccountResponse.SecurityPosture, and is useful for accessing the field via an interface.
-func (v *SecurityPostureOnlyAccountResponse) GetSecurityPosture() SecurityPostureOnlyAccountSecurityPosture {
-	return v.SecurityPosture
+// SearchCollectionsProvider includes the requested fields of the GraphQL type Provider.
+type SearchCollectionsProvider struct {
+	Collections SearchCollectionsProviderCollectionsCollectionsConnection `json:"collections"`
 }
 
-// SecurityPostureOnlyAccountSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
-type SecurityPostureOnlyAccountSecurityPosture struct {
-	Account SecurityPostureOnlyAccountSecurityPostureAccount `json:"account"`
+// GetCollections returns SearchCollectionsProvider.Collections, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProvider) GetCollections() SearchCollectionsProviderCollectionsCollectionsConnection {
+	return v.Collections
 }
 
-// GetAccount returns SecurityPostureOnlyAccountSecurityPosture.Account, and is useful for accessing the field via an interface.
-func (v *SecurityPostureOnlyAccountSecurityPosture) GetAccount() SecurityPostureOnlyAccountSecurityPostureAccount {
-	return v.Account
+// SearchCollectionsProviderCollectionsCollectionsConnection includes the requested fields of the GraphQL type CollectionsConnection.
+type SearchCollectionsProviderCollectionsCollectionsConnection struct {
+	ConnectionDataCollectionsConnection `json:"-"`
+	Edges                               []SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge `json:"edges"`
 }
 
-// SecurityPostureOnlyAccountSecurityPostureAccount includes the requested fields of the GraphQL type Account.
-type SecurityPostureOnlyAccountSecurityPostureAccount struct {
-	PolicyDataAccount `json:"-"`
-	AssetType         AssetType `json:"assetType"`
+// GetEdges returns SearchCollectionsProviderCollectionsCollectionsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) GetEdges() []SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge {
+	return v.Edges
 }
 
-// GetAssetType returns SecurityPostureOnlyAccountSecurityPostureAccount.AssetType, and is useful for accessing the field via an interface.
-func (v *SecurityPostureOnlyAccountSecurityPostureAccount) GetAssetType() AssetType {
-	return v.AssetType
+// GetTotalCount returns SearchCollectionsProviderCollectionsCollectionsConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) GetTotalCount() int {
+	return v.ConnectionDataCollectionsConnection.TotalCount
 }
 
-// GetPolicy returns SecurityPostureOnlyAccountSecurityPostureAccount.Policy, and is useful for accessing the field via an interface.
-func (v *SecurityPostureOnlyAccountSecurityPostureAccount) GetPolicy() PolicyDataPolicy {
-	return v.PolicyDataAccount.Policy
+// GetPageInfo returns SearchCollectionsProviderCollectionsCollectionsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) GetPageInfo() ConnectionDataPageInfo {
+	return v.ConnectionDataCollectionsConnection.PageInfo
 }
 
-func (v *SecurityPostureOnlyAccountSecurityPostureAccount) UnmarshalJSON(b []byte) error {
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SecurityPostureOnlyAccountSecurityPostureAccount
+		*SearchCollectionsProviderCollectionsCollectionsConnection
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SecurityPostureOnlyAccountSecurityPostureAccount = v
+	firstPass.SearchCollectionsProviderCollectionsCollectionsConnection = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3217,20 +3696,22 @@ func (v *SecurityPostureOnlyAccountSecurityPostureAccount) UnmarshalJSON(b []byt
 	}
 
 	err = json.Unmarshal(
-		b, &v.PolicyDataAccount)
+		b, &v.ConnectionDataCollectionsConnection)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalSecurityPostureOnlyAccountSecurityPostureAccount struct {
-	AssetType AssetType `json:"assetType"`
+type __premarshalSearchCollectionsProviderCollectionsCollectionsConnection struct {
+	Edges []SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge `json:"edges"`
 
-	Policy PolicyDataPolicy `json:"policy"`
+	TotalCount int `json:"totalCount"`
+
+	PageInfo ConnectionDataPageInfo `json:"pageInfo"`
 }
 
-func (v *SecurityPostureOnlyAccountSecurityPostureAccount) MarshalJSON() ([]byte, error) {
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3238,71 +3719,75 @@ func (v *SecurityPostureOnlyAccountSecurityPostureAccount) MarshalJSON() ([]byte
 	return json.Marshal(premarshaled)
 }
 
-func (v *SecurityPostureOnlyAccountSecurityPostureAccount) __premarshalJSON() (*__premarshalSecurityPostureOnlyAccountSecurityPostureAccount, error) {
-	var retval __premarshalSecurityPostureOnlyAccountSecurityPostureAccount
+func (v *SearchCollectionsProviderCollectionsCollectionsConnection) __premarshalJSON() (*__premarshalSearchCollectionsProviderCollectionsCollectionsConnection, error) {
+	var retval __premarshalSearchCollectionsProviderCollectionsCollectionsConnection
 
-	retval.AssetType = v.AssetType
-	retval.Policy = v.PolicyDataAccount.Policy
+	retval.Edges = v.Edges
+	retval.TotalCount = v.ConnectionDataCollectionsConnection.TotalCount
+	retval.PageInfo = v.ConnectionDataCollectionsConnection.PageInfo
 	return &retval, nil
 }
 
-// SecurityPostureResponse is returned by SecurityPosture on success.
-type SecurityPostureResponse struct {
-	SecurityPosture SecurityPostureSecurityPosture `json:"securityPosture"`
+// SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge includes the requested fields of the GraphQL type CollectionEdge.
+type SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge struct {
+	Cursor string                                                                                     `json:"cursor"`
+	Node   SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection `json:"node"`
 }
 
-// GetSecurityPosture returns SecurityPostureResponse.SecurityPosture, and is useful for accessing the field via an interface.
-func (v *SecurityPostureResponse) GetSecurityPosture() SecurityPostureSecurityPosture {
-	return v.SecurityPosture
+// GetCursor returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge.Cursor, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge) GetCursor() string {
+	return v.Cursor
 }
 
-// SecurityPostureSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
-type SecurityPostureSecurityPosture struct {
-	Filters   SecurityPostureSecurityPostureFiltersAvailableFilters      `json:"filters"`
-	Account   SecurityPostureSecurityPostureAccount                      `json:"account"`
-	Providers SecurityPostureSecurityPostureProvidersProvidersConnection `json:"providers"`
+// GetNode returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdge) GetNode() SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection {
+	return v.Node
 }
 
-// GetFilters returns SecurityPostureSecurityPosture.Filters, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPosture) GetFilters() SecurityPostureSecurityPostureFiltersAvailableFilters {
-	return v.Filters
+// SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection includes the requested fields of the GraphQL type Collection.
+type SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection struct {
+	CollectionId          string `json:"collectionId"`
+	Name                  string `json:"name"`
+	PolicyDataCollection  `json:"-"`
+	ScannerDataCollection `json:"-"`
 }
 
-// GetAccount returns SecurityPostureSecurityPosture.Account, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPosture) GetAccount() SecurityPostureSecurityPostureAccount {
-	return v.Account
+// GetCollectionId returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.CollectionId, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetCollectionId() string {
+	return v.CollectionId
 }
 
-// GetProviders returns SecurityPostureSecurityPosture.Providers, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPosture) GetProviders() SecurityPostureSecurityPostureProvidersProvidersConnection {
-	return v.Providers
+// GetName returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.Name, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetName() string {
+	return v.Name
 }
 
-// SecurityPostureSecurityPostureAccount includes the requested fields of the GraphQL type Account.
-type SecurityPostureSecurityPostureAccount struct {
-	PolicyDataAccount `json:"-"`
-	AssetType         AssetType `json:"assetType"`
+// GetPolicy returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.Policy, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataCollection.Policy
 }
 
-// GetAssetType returns SecurityPostureSecurityPostureAccount.AssetType, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureAccount) GetAssetType() AssetType { return v.AssetType }
+// GetSecurityCoverage returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.SecurityCoverage, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetSecurityCoverage() []ScannerDataSecurityCoverageSecurityCategoryCoverage {
+	return v.ScannerDataCollection.SecurityCoverage
+}
 
-// GetPolicy returns SecurityPostureSecurityPostureAccount.Policy, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureAccount) GetPolicy() PolicyDataPolicy {
-	return v.PolicyDataAccount.Policy
+// GetScanners returns SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection.Scanners, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) GetScanners() []ScannerDataScannersScanner {
+	return v.ScannerDataCollection.Scanners
 }
 
-func (v *SecurityPostureSecurityPostureAccount) UnmarshalJSON(b []byte) error {
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SecurityPostureSecurityPostureAccount
+		*SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SecurityPostureSecurityPostureAccount = v
+	firstPass.SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3310,20 +3795,31 @@ func (v *SecurityPostureSecurityPostureAccount) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.PolicyDataAccount)
+		b, &v.PolicyDataCollection)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(
+		b, &v.ScannerDataCollection)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalSecurityPostureSecurityPostureAccount struct {
-	AssetType AssetType `json:"assetType"`
+type __premarshalSearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection struct {
+	CollectionId string `json:"collectionId"`
+
+	Name string `json:"name"`
 
 	Policy PolicyDataPolicy `json:"policy"`
+
+	SecurityCoverage []ScannerDataSecurityCoverageSecurityCategoryCoverage `json:"securityCoverage"`
+
+	Scanners []ScannerDataScannersScanner `json:"scanners"`
 }
 
-func (v *SecurityPostureSecurityPostureAccount) MarshalJSON() ([]byte, error) {
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3331,70 +3827,479 @@ func (v *SecurityPostureSecurityPostureAccount) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *SecurityPostureSecurityPostureAccount) __premarshalJSON() (*__premarshalSecurityPostureSecurityPostureAccount, error) {
-	var retval __premarshalSecurityPostureSecurityPostureAccount
+func (v *SearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection) __premarshalJSON() (*__premarshalSearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection, error) {
+	var retval __premarshalSearchCollectionsProviderCollectionsCollectionsConnectionEdgesCollectionEdgeNodeCollection
 
-	retval.AssetType = v.AssetType
-	retval.Policy = v.PolicyDataAccount.Policy
+	retval.CollectionId = v.CollectionId
+	retval.Name = v.Name
+	retval.Policy = v.PolicyDataCollection.Policy
+	retval.SecurityCoverage = v.ScannerDataCollection.SecurityCoverage
+	retval.Scanners = v.ScannerDataCollection.Scanners
 	return &retval, nil
 }
 
-// SecurityPostureSecurityPostureFiltersAvailableFilters includes the requested fields of the GraphQL type AvailableFilters.
-type SecurityPostureSecurityPostureFiltersAvailableFilters struct {
-	ResourceProvisioningStatus []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceProvisioningStatusBasicFilterCount              `json:"resourceProvisioningStatus"`
-	Collection                 []SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay `json:"collection"`
-	MissingCoverage            []SecurityPostureSecurityPostureFiltersAvailableFiltersMissingCoverageBasicFilterCount                         `json:"missingCoverage"`
-	ResourceAttribute          []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceAttributeBasicFilterCount                       `json:"resourceAttribute"`
-	PolicyType                 []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyTypeBasicFilterCount                              `json:"policyType"`
-	Policy                     []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay         `json:"policy"`
-	ProvisionedAnalyzer        []SecurityPostureSecurityPostureFiltersAvailableFiltersProvisionedAnalyzerProvisionedAnalyzerFilterCount       `json:"provisionedAnalyzer"`
+// SearchCollectionsResponse is returned by SearchCollections on success.
+type SearchCollectionsResponse struct {
+	Provider SearchCollectionsProvider `json:"provider"`
 }
 
-// GetResourceProvisioningStatus returns SecurityPostureSecurityPostureFiltersAvailableFilters.ResourceProvisioningStatus, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetResourceProvisioningStatus() []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceProvisioningStatusBasicFilterCount {
-	return v.ResourceProvisioningStatus
+// GetProvider returns SearchCollectionsResponse.Provider, and is useful for accessing the field via an interface.
+func (v *SearchCollectionsResponse) GetProvider() SearchCollectionsProvider { return v.Provider }
+
+// SearchResourcesProvider includes the requested fields of the GraphQL type Provider.
+type SearchResourcesProvider struct {
+	Collection SearchResourcesProviderCollection `json:"collection"`
 }
 
-// GetCollection returns SecurityPostureSecurityPostureFiltersAvailableFilters.Collection, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetCollection() []SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay {
+// GetCollection returns SearchResourcesProvider.Collection, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProvider) GetCollection() SearchResourcesProviderCollection {
 	return v.Collection
 }
 
-// GetMissingCoverage returns SecurityPostureSecurityPostureFiltersAvailableFilters.MissingCoverage, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetMissingCoverage() []SecurityPostureSecurityPostureFiltersAvailableFiltersMissingCoverageBasicFilterCount {
-	return v.MissingCoverage
+// SearchResourcesProviderCollection includes the requested fields of the GraphQL type Collection.
+type SearchResourcesProviderCollection struct {
+	Resources SearchResourcesProviderCollectionResourcesResourcesConnection `json:"resources"`
 }
 
-// GetResourceAttribute returns SecurityPostureSecurityPostureFiltersAvailableFilters.ResourceAttribute, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetResourceAttribute() []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceAttributeBasicFilterCount {
-	return v.ResourceAttribute
+// GetResources returns SearchResourcesProviderCollection.Resources, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollection) GetResources() SearchResourcesProviderCollectionResourcesResourcesConnection {
+	return v.Resources
 }
 
-// GetPolicyType returns SecurityPostureSecurityPostureFiltersAvailableFilters.PolicyType, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetPolicyType() []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyTypeBasicFilterCount {
-	return v.PolicyType
+// SearchResourcesProviderCollectionResourcesResourcesConnection includes the requested fields of the GraphQL type ResourcesConnection.
+type SearchResourcesProviderCollectionResourcesResourcesConnection struct {
+	ConnectionDataResourcesConnection `json:"-"`
+	Edges                             []SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge `json:"edges"`
 }
 
-// GetPolicy returns SecurityPostureSecurityPostureFiltersAvailableFilters.Policy, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetPolicy() []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay {
-	return v.Policy
+// GetEdges returns SearchResourcesProviderCollectionResourcesResourcesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) GetEdges() []SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge {
+	return v.Edges
 }
 
-// GetProvisionedAnalyzer returns SecurityPostureSecurityPostureFiltersAvailableFilters.ProvisionedAnalyzer, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetProvisionedAnalyzer() []SecurityPostureSecurityPostureFiltersAvailableFiltersProvisionedAnalyzerProvisionedAnalyzerFilterCount {
-	return v.ProvisionedAnalyzer
+// GetTotalCount returns SearchResourcesProviderCollectionResourcesResourcesConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) GetTotalCount() int {
+	return v.ConnectionDataResourcesConnection.TotalCount
 }
 
-// SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay includes the requested fields of the GraphQL type CollectionFilterDisplayFilterCountWithDisplay.
-type SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay struct {
-	FilterDataCollectionFilterDisplayFilterCountWithDisplay `json:"-"`
-	Display                                                 SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplayDisplayCollectionFilterDisplay `json:"display"`
+// GetPageInfo returns SearchResourcesProviderCollectionResourcesResourcesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) GetPageInfo() ConnectionDataPageInfo {
+	return v.ConnectionDataResourcesConnection.PageInfo
 }
 
-// GetDisplay returns SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay.Display, and is useful for accessing the field via an interface.
-func (v *SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay) GetDisplay() SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplayDisplayCollectionFilterDisplay {
-	return v.Display
-}
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchResourcesProviderCollectionResourcesResourcesConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchResourcesProviderCollectionResourcesResourcesConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ConnectionDataResourcesConnection)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchResourcesProviderCollectionResourcesResourcesConnection struct {
+	Edges []SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge `json:"edges"`
+
+	TotalCount int `json:"totalCount"`
+
+	PageInfo ConnectionDataPageInfo `json:"pageInfo"`
+}
+
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnection) __premarshalJSON() (*__premarshalSearchResourcesProviderCollectionResourcesResourcesConnection, error) {
+	var retval __premarshalSearchResourcesProviderCollectionResourcesResourcesConnection
+
+	retval.Edges = v.Edges
+	retval.TotalCount = v.ConnectionDataResourcesConnection.TotalCount
+	retval.PageInfo = v.ConnectionDataResourcesConnection.PageInfo
+	return &retval, nil
+}
+
+// SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge includes the requested fields of the GraphQL type ResourceEdge.
+type SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge struct {
+	Cursor string                                                                                     `json:"cursor"`
+	Node   SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource `json:"node"`
+}
+
+// GetCursor returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge.Cursor, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge) GetCursor() string {
+	return v.Cursor
+}
+
+// GetNode returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge.Node, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdge) GetNode() SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource {
+	return v.Node
+}
+
+// SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource includes the requested fields of the GraphQL type Resource.
+type SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource struct {
+	ResourceId          string `json:"resourceId"`
+	Name                string `json:"name"`
+	PolicyDataResource  `json:"-"`
+	ScannerDataResource `json:"-"`
+}
+
+// GetResourceId returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.ResourceId, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetResourceId() string {
+	return v.ResourceId
+}
+
+// GetName returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.Name, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetName() string {
+	return v.Name
+}
+
+// GetPolicy returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.Policy, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataResource.Policy
+}
+
+// GetSecurityCoverage returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.SecurityCoverage, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetSecurityCoverage() []ScannerDataSecurityCoverageSecurityCategoryCoverage {
+	return v.ScannerDataResource.SecurityCoverage
+}
+
+// GetScanners returns SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource.Scanners, and is useful for accessing the field via an interface.
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) GetScanners() []ScannerDataScannersScanner {
+	return v.ScannerDataResource.Scanners
+}
+
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataResource)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(
+		b, &v.ScannerDataResource)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource struct {
+	ResourceId string `json:"resourceId"`
+
+	Name string `json:"name"`
+
+	Policy PolicyDataPolicy `json:"policy"`
+
+	SecurityCoverage []ScannerDataSecurityCoverageSecurityCategoryCoverage `json:"securityCoverage"`
+
+	Scanners []ScannerDataScannersScanner `json:"scanners"`
+}
+
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource) __premarshalJSON() (*__premarshalSearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource, error) {
+	var retval __premarshalSearchResourcesProviderCollectionResourcesResourcesConnectionEdgesResourceEdgeNodeResource
+
+	retval.ResourceId = v.ResourceId
+	retval.Name = v.Name
+	retval.Policy = v.PolicyDataResource.Policy
+	retval.SecurityCoverage = v.ScannerDataResource.SecurityCoverage
+	retval.Scanners = v.ScannerDataResource.Scanners
+	return &retval, nil
+}
+
+// SearchResourcesResponse is returned by SearchResources on success.
+type SearchResourcesResponse struct {
+	Provider SearchResourcesProvider `json:"provider"`
+}
+
+// GetProvider returns SearchResourcesResponse.Provider, and is useful for accessing the field via an interface.
+func (v *SearchResourcesResponse) GetProvider() SearchResourcesProvider { return v.Provider }
+
+type SecurityCategory string
+
+const (
+	SecurityCategoryCicd    SecurityCategory = "CICD"
+	SecurityCategoryIac     SecurityCategory = "IAC"
+	SecurityCategorySast    SecurityCategory = "SAST"
+	SecurityCategorySbom    SecurityCategory = "SBOM"
+	SecurityCategorySca     SecurityCategory = "SCA"
+	SecurityCategorySci     SecurityCategory = "SCI"
+	SecurityCategorySecrets SecurityCategory = "SECRETS"
+	SecurityCategoryLicense SecurityCategory = "LICENSE"
+)
+
+// SecurityPostureOnlyAccountResponse is returned by SecurityPostureOnlyAccount on success.
+type SecurityPostureOnlyAccountResponse struct {
+	SecurityPosture SecurityPostureOnlyAccountSecurityPosture `json:"securityPosture"`
+}
+
+// GetSecurityPosture returns SecurityPostureOnlyAccountResponse.SecurityPosture, and is useful for accessing the field via an interface.
+func (v *SecurityPostureOnlyAccountResponse) GetSecurityPosture() SecurityPostureOnlyAccountSecurityPosture {
+	return v.SecurityPosture
+}
+
+// SecurityPostureOnlyAccountSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
+type SecurityPostureOnlyAccountSecurityPosture struct {
+	Account SecurityPostureOnlyAccountSecurityPostureAccount `json:"account"`
+}
+
+// GetAccount returns SecurityPostureOnlyAccountSecurityPosture.Account, and is useful for accessing the field via an interface.
+func (v *SecurityPostureOnlyAccountSecurityPosture) GetAccount() SecurityPostureOnlyAccountSecurityPostureAccount {
+	return v.Account
+}
+
+// SecurityPostureOnlyAccountSecurityPostureAccount includes the requested fields of the GraphQL type Account.
+type SecurityPostureOnlyAccountSecurityPostureAccount struct {
+	PolicyDataAccount `json:"-"`
+	AssetType         AssetType `json:"assetType"`
+}
+
+// GetAssetType returns SecurityPostureOnlyAccountSecurityPostureAccount.AssetType, and is useful for accessing the field via an interface.
+func (v *SecurityPostureOnlyAccountSecurityPostureAccount) GetAssetType() AssetType {
+	return v.AssetType
+}
+
+// GetPolicy returns SecurityPostureOnlyAccountSecurityPostureAccount.Policy, and is useful for accessing the field via an interface.
+func (v *SecurityPostureOnlyAccountSecurityPostureAccount) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataAccount.Policy
+}
+
+func (v *SecurityPostureOnlyAccountSecurityPostureAccount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SecurityPostureOnlyAccountSecurityPostureAccount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SecurityPostureOnlyAccountSecurityPostureAccount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataAccount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSecurityPostureOnlyAccountSecurityPostureAccount struct {
+	AssetType AssetType `json:"assetType"`
+
+	Policy PolicyDataPolicy `json:"policy"`
+}
+
+func (v *SecurityPostureOnlyAccountSecurityPostureAccount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SecurityPostureOnlyAccountSecurityPostureAccount) __premarshalJSON() (*__premarshalSecurityPostureOnlyAccountSecurityPostureAccount, error) {
+	var retval __premarshalSecurityPostureOnlyAccountSecurityPostureAccount
+
+	retval.AssetType = v.AssetType
+	retval.Policy = v.PolicyDataAccount.Policy
+	return &retval, nil
+}
+
+// SecurityPostureResponse is returned by SecurityPosture on success.
+type SecurityPostureResponse struct {
+	SecurityPosture SecurityPostureSecurityPosture `json:"securityPosture"`
+}
+
+// GetSecurityPosture returns SecurityPostureResponse.SecurityPosture, and is useful for accessing the field via an interface.
+func (v *SecurityPostureResponse) GetSecurityPosture() SecurityPostureSecurityPosture {
+	return v.SecurityPosture
+}
+
+// SecurityPostureSecurityPosture includes the requested fields of the GraphQL type SecurityPosture.
+type SecurityPostureSecurityPosture struct {
+	Filters   SecurityPostureSecurityPostureFiltersAvailableFilters      `json:"filters"`
+	Account   SecurityPostureSecurityPostureAccount                      `json:"account"`
+	Providers SecurityPostureSecurityPostureProvidersProvidersConnection `json:"providers"`
+}
+
+// GetFilters returns SecurityPostureSecurityPosture.Filters, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPosture) GetFilters() SecurityPostureSecurityPostureFiltersAvailableFilters {
+	return v.Filters
+}
+
+// GetAccount returns SecurityPostureSecurityPosture.Account, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPosture) GetAccount() SecurityPostureSecurityPostureAccount {
+	return v.Account
+}
+
+// GetProviders returns SecurityPostureSecurityPosture.Providers, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPosture) GetProviders() SecurityPostureSecurityPostureProvidersProvidersConnection {
+	return v.Providers
+}
+
+// SecurityPostureSecurityPostureAccount includes the requested fields of the GraphQL type Account.
+type SecurityPostureSecurityPostureAccount struct {
+	PolicyDataAccount `json:"-"`
+	AssetType         AssetType `json:"assetType"`
+}
+
+// GetAssetType returns SecurityPostureSecurityPostureAccount.AssetType, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureAccount) GetAssetType() AssetType { return v.AssetType }
+
+// GetPolicy returns SecurityPostureSecurityPostureAccount.Policy, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureAccount) GetPolicy() PolicyDataPolicy {
+	return v.PolicyDataAccount.Policy
+}
+
+func (v *SecurityPostureSecurityPostureAccount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SecurityPostureSecurityPostureAccount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SecurityPostureSecurityPostureAccount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PolicyDataAccount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSecurityPostureSecurityPostureAccount struct {
+	AssetType AssetType `json:"assetType"`
+
+	Policy PolicyDataPolicy `json:"policy"`
+}
+
+func (v *SecurityPostureSecurityPostureAccount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SecurityPostureSecurityPostureAccount) __premarshalJSON() (*__premarshalSecurityPostureSecurityPostureAccount, error) {
+	var retval __premarshalSecurityPostureSecurityPostureAccount
+
+	retval.AssetType = v.AssetType
+	retval.Policy = v.PolicyDataAccount.Policy
+	return &retval, nil
+}
+
+// SecurityPostureSecurityPostureFiltersAvailableFilters includes the requested fields of the GraphQL type AvailableFilters.
+type SecurityPostureSecurityPostureFiltersAvailableFilters struct {
+	ResourceProvisioningStatus []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceProvisioningStatusBasicFilterCount              `json:"resourceProvisioningStatus"`
+	Collection                 []SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay `json:"collection"`
+	MissingCoverage            []SecurityPostureSecurityPostureFiltersAvailableFiltersMissingCoverageBasicFilterCount                         `json:"missingCoverage"`
+	ResourceAttribute          []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceAttributeBasicFilterCount                       `json:"resourceAttribute"`
+	PolicyType                 []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyTypeBasicFilterCount                              `json:"policyType"`
+	Policy                     []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay         `json:"policy"`
+	ProvisionedAnalyzer        []SecurityPostureSecurityPostureFiltersAvailableFiltersProvisionedAnalyzerProvisionedAnalyzerFilterCount       `json:"provisionedAnalyzer"`
+}
+
+// GetResourceProvisioningStatus returns SecurityPostureSecurityPostureFiltersAvailableFilters.ResourceProvisioningStatus, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetResourceProvisioningStatus() []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceProvisioningStatusBasicFilterCount {
+	return v.ResourceProvisioningStatus
+}
+
+// GetCollection returns SecurityPostureSecurityPostureFiltersAvailableFilters.Collection, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetCollection() []SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay {
+	return v.Collection
+}
+
+// GetMissingCoverage returns SecurityPostureSecurityPostureFiltersAvailableFilters.MissingCoverage, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetMissingCoverage() []SecurityPostureSecurityPostureFiltersAvailableFiltersMissingCoverageBasicFilterCount {
+	return v.MissingCoverage
+}
+
+// GetResourceAttribute returns SecurityPostureSecurityPostureFiltersAvailableFilters.ResourceAttribute, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetResourceAttribute() []SecurityPostureSecurityPostureFiltersAvailableFiltersResourceAttributeBasicFilterCount {
+	return v.ResourceAttribute
+}
+
+// GetPolicyType returns SecurityPostureSecurityPostureFiltersAvailableFilters.PolicyType, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetPolicyType() []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyTypeBasicFilterCount {
+	return v.PolicyType
+}
+
+// GetPolicy returns SecurityPostureSecurityPostureFiltersAvailableFilters.Policy, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetPolicy() []SecurityPostureSecurityPostureFiltersAvailableFiltersPolicyPolicyFilterDisplayFilterCountWithDisplay {
+	return v.Policy
+}
+
+// GetProvisionedAnalyzer returns SecurityPostureSecurityPostureFiltersAvailableFilters.ProvisionedAnalyzer, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFilters) GetProvisionedAnalyzer() []SecurityPostureSecurityPostureFiltersAvailableFiltersProvisionedAnalyzerProvisionedAnalyzerFilterCount {
+	return v.ProvisionedAnalyzer
+}
+
+// SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay includes the requested fields of the GraphQL type CollectionFilterDisplayFilterCountWithDisplay.
+type SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay struct {
+	FilterDataCollectionFilterDisplayFilterCountWithDisplay `json:"-"`
+	Display                                                 SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplayDisplayCollectionFilterDisplay `json:"display"`
+}
+
+// GetDisplay returns SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay.Display, and is useful for accessing the field via an interface.
+func (v *SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay) GetDisplay() SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplayDisplayCollectionFilterDisplay {
+	return v.Display
+}
 
 // GetValue returns SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay.Value, and is useful for accessing the field via an interface.
 func (v *SecurityPostureSecurityPostureFiltersAvailableFiltersCollectionCollectionFilterDisplayFilterCountWithDisplay) GetValue() string {
@@ -4984,11 +5889,21 @@ type __FilteredSecurityPostureInput struct {
 // GetFilters returns __FilteredSecurityPostureInput.Filters, and is useful for accessing the field via an interface.
 func (v *__FilteredSecurityPostureInput) GetFilters() Filters { return v.Filters }
 
+// __PolicyAssetsInput is used internally by genqlient
+type __PolicyAssetsInput struct {
+	PolicyId string `json:"policyId"`
+}
+
+// GetPolicyId returns __PolicyAssetsInput.PolicyId, and is useful for accessing the field via an interface.
+func (v *__PolicyAssetsInput) GetPolicyId() string { return v.PolicyId }
+
 // __ProviderCollectionInput is used internally by genqlient
 type __ProviderCollectionInput struct {
-	ProviderId   string `json:"providerId"`
-	CollectionId string `json:"collectionId"`
-	First        int    `json:"first"`
+	ProviderId   string   `json:"providerId"`
+	CollectionId string   `json:"collectionId"`
+	Filters      *Filters `json:"filters"`
+	First        int      `json:"first"`
+	After        string   `json:"after,omitempty"`
 }
 
 // GetProviderId returns __ProviderCollectionInput.ProviderId, and is useful for accessing the field via an interface.
@@ -4997,21 +5912,35 @@ func (v *__ProviderCollectionInput) GetProviderId() string { return v.ProviderId
 // GetCollectionId returns __ProviderCollectionInput.CollectionId, and is useful for accessing the field via an interface.
 func (v *__ProviderCollectionInput) GetCollectionId() string { return v.CollectionId }
 
+// GetFilters returns __ProviderCollectionInput.Filters, and is useful for accessing the field via an interface.
+func (v *__ProviderCollectionInput) GetFilters() *Filters { return v.Filters }
+
 // GetFirst returns __ProviderCollectionInput.First, and is useful for accessing the field via an interface.
 func (v *__ProviderCollectionInput) GetFirst() int { return v.First }
 
+// GetAfter returns __ProviderCollectionInput.After, and is useful for accessing the field via an interface.
+func (v *__ProviderCollectionInput) GetAfter() string { return v.After }
+
 // __ProviderCollectionsInput is used internally by genqlient
 type __ProviderCollectionsInput struct {
-	ProviderId string `json:"providerId"`
-	First      int    `json:"first"`
+	ProviderId string   `json:"providerId"`
+	Filters    *Filters `json:"filters"`
+	First      int      `json:"first"`
+	After      string   `json:"after,omitempty"`
 }
 
 // GetProviderId returns __ProviderCollectionsInput.ProviderId, and is useful for accessing the field via an interface.
 func (v *__ProviderCollectionsInput) GetProviderId() string { return v.ProviderId }
 
+// GetFilters returns __ProviderCollectionsInput.Filters, and is useful for accessing the field via an interface.
+func (v *__ProviderCollectionsInput) GetFilters() *Filters { return v.Filters }
+
 // GetFirst returns __ProviderCollectionsInput.First, and is useful for accessing the field via an interface.
 func (v *__ProviderCollectionsInput) GetFirst() int { return v.First }
 
+// GetAfter returns __ProviderCollectionsInput.After, and is useful for accessing the field via an interface.
+func (v *__ProviderCollectionsInput) GetAfter() string { return v.After }
+
 // __ProviderInput is used internally by genqlient
 type __ProviderInput struct {
 	ProviderId string `json:"providerId"`
@@ -5036,6 +5965,50 @@ type __RemoveScannerConfigsInput struct {
 // GetAnalyzerId returns __RemoveScannerConfigsInput.AnalyzerId, and is useful for accessing the field via an interface.
 func (v *__RemoveScannerConfigsInput) GetAnalyzerId() string { return v.AnalyzerId }
 
+// __SearchCollectionsInput is used internally by genqlient
+type __SearchCollectionsInput struct {
+	ProviderId string `json:"providerId"`
+	Search     string `json:"search"`
+	First      int    `json:"first"`
+	After      string `json:"after,omitempty"`
+}
+
+// GetProviderId returns __SearchCollectionsInput.ProviderId, and is useful for accessing the field via an interface.
+func (v *__SearchCollectionsInput) GetProviderId() string { return v.ProviderId }
+
+// GetSearch returns __SearchCollectionsInput.Search, and is useful for accessing the field via an interface.
+func (v *__SearchCollectionsInput) GetSearch() string { return v.Search }
+
+// GetFirst returns __SearchCollectionsInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchCollectionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __SearchCollectionsInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchCollectionsInput) GetAfter() string { return v.After }
+
+// __SearchResourcesInput is used internally by genqlient
+type __SearchResourcesInput struct {
+	ProviderId   string `json:"providerId"`
+	CollectionId string `json:"collectionId"`
+	Search       string `json:"search"`
+	First        int    `json:"first"`
+	After        string `json:"after,omitempty"`
+}
+
+// GetProviderId returns __SearchResourcesInput.ProviderId, and is useful for accessing the field via an interface.
+func (v *__SearchResourcesInput) GetProviderId() string { return v.ProviderId }
+
+// GetCollectionId returns __SearchResourcesInput.CollectionId, and is useful for accessing the field via an interface.
+func (v *__SearchResourcesInput) GetCollectionId() string { return v.CollectionId }
+
+// GetSearch returns __SearchResourcesInput.Search, and is useful for accessing the field via an interface.
+func (v *__SearchResourcesInput) GetSearch() string { return v.Search }
+
+// GetFirst returns __SearchResourcesInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchResourcesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __SearchResourcesInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchResourcesInput) GetAfter() string { return v.After }
+
 // __SetAccountAutoAssignmentInput is used internally by genqlient
 type __SetAccountAutoAssignmentInput struct {
 	AnalyzerIds []string `json:"analyzerIds"`
@@ -5236,14 +6209,14 @@ query ExternalDataValidation {
 			edges {
 				node {
 					providerId
-					collections {
+					collections(first: 1) {
 						edges {
 							node {
 								collectionId
 								name
 								... ScannerData
 								... PolicyData
-								resources {
+								resources(first: 1) {
 									edges {
 										node {
 											resourceId
@@ -5292,7 +6265,7 @@ fragment PolicyData on HasPolicy {
 }
 `
 
-// Query with precise fields to assert external dependencies
+// Query with precise fields to assert external dependencies, a single item is enough to validate the shape
 func ExternalDataValidation(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -5410,18 +6383,122 @@ query FilteredSecurityPosture ($filters: Filters) {
 		providers {
 			edges {
 				node {
-					providerId
-					stats {
-						totalResources
-					}
-					collections {
+					providerId
+					stats {
+						totalResources
+					}
+					collections {
+						edges {
+							node {
+								collectionId
+								resources {
+									edges {
+										node {
+											resourceId
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+fragment FilterData on FilterCount {
+	value
+	count
+}
+`
+
+// The input types are generated once, the options of Filters apply to every query using it.
+func FilteredSecurityPosture(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filters Filters,
+) (*FilteredSecurityPostureResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "FilteredSecurityPosture",
+		Query:  FilteredSecurityPosture_Operation,
+		Variables: &__FilteredSecurityPostureInput{
+			Filters: filters,
+		},
+	}
+	var err_ error
+
+	var data_ FilteredSecurityPostureResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by Policies.
+const Policies_Operation = `
+query Policies {
+	securityPosture {
+		filters {
+			policy {
+				... FilterData
+				display {
+					name
+				}
+			}
+		}
+	}
+}
+fragment FilterData on FilterCount {
+	value
+	count
+}
+`
+
+func Policies(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*PoliciesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Policies",
+		Query:  Policies_Operation,
+	}
+	var err_ error
+
+	var data_ PoliciesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by PolicyAssets.
+const PolicyAssets_Operation = `
+query PolicyAssets ($policyId: String!) {
+	securityPosture(filters: {policy:[$policyId]}) {
+		account {
+			... PolicyData
+		}
+		providers {
+			edges {
+				node {
+					collections(first: 1) {
 						edges {
 							node {
-								collectionId
-								resources {
+								... PolicyData
+								resources(first: 1) {
 									edges {
 										node {
-											resourceId
+											... PolicyData
 										}
 									}
 								}
@@ -5433,27 +6510,32 @@ query FilteredSecurityPosture ($filters: Filters) {
 		}
 	}
 }
-fragment FilterData on FilterCount {
-	value
-	count
+fragment PolicyData on HasPolicy {
+	policy {
+		policyId
+		name
+		source
+		assignment
+	}
 }
 `
 
-func FilteredSecurityPosture(
+// The source of a policy is only exposed on the assets it is assigned to, the first ones are enough to find it
+func PolicyAssets(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	filters Filters,
-) (*FilteredSecurityPostureResponse, error) {
+	policyId string,
+) (*PolicyAssetsResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "FilteredSecurityPosture",
-		Query:  FilteredSecurityPosture_Operation,
-		Variables: &__FilteredSecurityPostureInput{
-			Filters: filters,
+		OpName: "PolicyAssets",
+		Query:  PolicyAssets_Operation,
+		Variables: &__PolicyAssetsInput{
+			PolicyId: policyId,
 		},
 	}
 	var err_ error
 
-	var data_ FilteredSecurityPostureResponse
+	var data_ PolicyAssetsResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -5547,11 +6629,11 @@ func Provider(
 
 // The query or mutation executed by ProviderCollection.
 const ProviderCollection_Operation = `
-query ProviderCollection ($providerId: String!, $collectionId: String!, $first: Int) {
-	provider(providerId: $providerId) {
+query ProviderCollection ($providerId: String!, $collectionId: String!, $filters: Filters, $first: Int, $after: String) {
+	provider(providerId: $providerId, filters: $filters) {
 		collection(collectionId: $collectionId) {
 			collectionId
-			resources(first: $first) {
+			resources(first: $first, after: $after) {
 				... ConnectionData
 				edges {
 					cursor
@@ -5613,7 +6695,9 @@ func ProviderCollection(
 	client_ graphql.Client,
 	providerId string,
 	collectionId string,
+	filters *Filters,
 	first int,
+	after string,
 ) (*ProviderCollectionResponse, error) {
 	req_ := &graphql.Request{
 		OpName: "ProviderCollection",
@@ -5621,7 +6705,9 @@ func ProviderCollection(
 		Variables: &__ProviderCollectionInput{
 			ProviderId:   providerId,
 			CollectionId: collectionId,
+			Filters:      filters,
 			First:        first,
+			After:        after,
 		},
 	}
 	var err_ error
@@ -5640,9 +6726,9 @@ func ProviderCollection(
 
 // The query or mutation executed by ProviderCollections.
 const ProviderCollections_Operation = `
-query ProviderCollections ($providerId: String!, $first: Int) {
-	provider(providerId: $providerId) {
-		collections(first: $first) {
+query ProviderCollections ($providerId: String!, $filters: Filters, $first: Int, $after: String) {
+	provider(providerId: $providerId, filters: $filters) {
+		collections(first: $first, after: $after) {
 			... ConnectionData
 			edges {
 				cursor
@@ -5717,14 +6803,18 @@ func ProviderCollections(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	providerId string,
+	filters *Filters,
 	first int,
+	after string,
 ) (*ProviderCollectionsResponse, error) {
 	req_ := &graphql.Request{
 		OpName: "ProviderCollections",
 		Query:  ProviderCollections_Operation,
 		Variables: &__ProviderCollectionsInput{
 			ProviderId: providerId,
+			Filters:    filters,
 			First:      first,
+			After:      after,
 		},
 	}
 	var err_ error
@@ -5741,6 +6831,55 @@ func ProviderCollections(
 	return &data_, err_
 }
 
+// The query or mutation executed by Providers.
+const Providers_Operation = `
+query Providers {
+	securityPosture {
+		providers {
+			... ConnectionData
+			edges {
+				cursor
+				node {
+					providerId
+					name
+				}
+			}
+		}
+	}
+}
+fragment ConnectionData on Connection {
+	totalCount
+	pageInfo {
+		hasNextPage
+		hasPreviousPage
+		startCursor
+		endCursor
+	}
+}
+`
+
+func Providers(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*ProvidersResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Providers",
+		Query:  Providers_Operation,
+	}
+	var err_ error
+
+	var data_ ProvidersResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ProvisionPlan.
 const ProvisionPlan_Operation = `
 query ProvisionPlan ($assetSelections: [AssetSelection!]!) {
@@ -5880,6 +7019,192 @@ func ScannerConfigs(
 	return &data_, err_
 }
 
+// The query or mutation executed by SearchCollections.
+const SearchCollections_Operation = `
+query SearchCollections ($providerId: String!, $search: String!, $first: Int, $after: String) {
+	provider(providerId: $providerId, filters: {search:$search}) {
+		collections(first: $first, after: $after) {
+			... ConnectionData
+			edges {
+				cursor
+				node {
+					collectionId
+					name
+					... PolicyData
+					... ScannerData
+				}
+			}
+		}
+	}
+}
+fragment ConnectionData on Connection {
+	totalCount
+	pageInfo {
+		hasNextPage
+		hasPreviousPage
+		startCursor
+		endCursor
+	}
+}
+fragment PolicyData on HasPolicy {
+	policy {
+		policyId
+		name
+		source
+		assignment
+	}
+}
+fragment ScannerData on HasScanners {
+	securityCoverage {
+		category
+		state
+		activity
+	}
+	scanners {
+		scannerId
+		name
+		categories
+		state
+		activity
+		provisioningMethod
+		error {
+			message
+		}
+		ruleset {
+			id
+			name
+		}
+	}
+}
+`
+
+func SearchCollections(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	providerId string,
+	search string,
+	first int,
+	after string,
+) (*SearchCollectionsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "SearchCollections",
+		Query:  SearchCollections_Operation,
+		Variables: &__SearchCollectionsInput{
+			ProviderId: providerId,
+			Search:     search,
+			First:      first,
+			After:      after,
+		},
+	}
+	var err_ error
+
+	var data_ SearchCollectionsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by SearchResources.
+const SearchResources_Operation = `
+query SearchResources ($providerId: String!, $collectionId: String!, $search: String!, $first: Int, $after: String) {
+	provider(providerId: $providerId, filters: {search:$search}) {
+		collection(collectionId: $collectionId) {
+			resources(first: $first, after: $after) {
+				... ConnectionData
+				edges {
+					cursor
+					node {
+						resourceId
+						name
+						... PolicyData
+						... ScannerData
+					}
+				}
+			}
+		}
+	}
+}
+fragment ConnectionData on Connection {
+	totalCount
+	pageInfo {
+		hasNextPage
+		hasPreviousPage
+		startCursor
+		endCursor
+	}
+}
+fragment PolicyData on HasPolicy {
+	policy {
+		policyId
+		name
+		source
+		assignment
+	}
+}
+fragment ScannerData on HasScanners {
+	securityCoverage {
+		category
+		state
+		activity
+	}
+	scanners {
+		scannerId
+		name
+		categories
+		state
+		activity
+		provisioningMethod
+		error {
+			message
+		}
+		ruleset {
+			id
+			name
+		}
+	}
+}
+`
+
+func SearchResources(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	providerId string,
+	collectionId string,
+	search string,
+	first int,
+	after string,
+) (*SearchResourcesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "SearchResources",
+		Query:  SearchResources_Operation,
+		Variables: &__SearchResourcesInput{
+			ProviderId:   providerId,
+			CollectionId: collectionId,
+			Search:       search,
+			First:        first,
+			After:        after,
+		},
+	}
+	var err_ error
+
+	var data_ SearchResourcesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by SecurityPosture.
 const SecurityPosture_Operation = `
 query SecurityPosture {
@@ -0,0 +1,115 @@
+package boostsecurity
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FindProvider resolves a provider by name, without its collections. It returns nil when no provider matches.
+func (c *Client) FindProvider(ctx context.Context, name string) (*ProviderModel, error) {
+	result, err := Providers(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting providers %w", err)
+	}
+
+	for _, edge := range result.SecurityPosture.Providers.Edges {
+		if edge.Node.Name == name {
+			return &ProviderModel{Name: edge.Node.Name, ID: edge.Node.ProviderId}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindCollection resolves a collection of a provider by name, without its resources. It returns nil when no
+// collection matches. The search of the API may not match every name, the collections are listed before
+// concluding that none matches.
+func (c *Client) FindCollection(ctx context.Context, providerId string, name string) (*OrganizationModel, error) {
+	var collection *OrganizationModel
+	err := paginate(func(after string) (*ConnectionDataPageInfo, error) {
+		result, err := SearchCollections(ctx, *c.client, providerId, name, c.pageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("error searching collections %w", err)
+		}
+
+		for _, edge := range result.Provider.Collections.Edges {
+			node := edge.Node
+			if node.Name == name {
+				collection = &OrganizationModel{
+					Name:             node.Name,
+					ID:               node.CollectionId,
+					Scanners:         toProvisionedScanners(node.Scanners),
+					Policy:           node.Policy.PolicyId,
+					PolicyAssignment: node.Policy.Assignment,
+				}
+				// The search may match other collections, the remaining pages are not needed.
+				return &ConnectionDataPageInfo{}, nil
+			}
+		}
+
+		return &result.Provider.Collections.PageInfo, nil
+	})
+	if err != nil || collection != nil {
+		return collection, err
+	}
+
+	tflog.Debug(ctx, "Collection not matched by search, listing collections", map[string]any{"collection": name})
+	collections, err := c.listCollections(ctx, providerId, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range collections {
+		if collections[i].Name == name {
+			return &collections[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindResource resolves a resource of a collection by name. It returns nil when no resource matches. The search
+// of the API may not match every name, the resources of the collection are listed before concluding that none
+// matches.
+func (c *Client) FindResource(ctx context.Context, providerId string, collectionId string, name string) (*ResourcesModel, error) {
+	var resource *ResourcesModel
+	err := paginate(func(after string) (*ConnectionDataPageInfo, error) {
+		result, err := SearchResources(ctx, *c.client, providerId, collectionId, name, c.pageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("error searching resources %w", err)
+		}
+
+		for _, edge := range result.Provider.Collection.Resources.Edges {
+			node := edge.Node
+			if node.Name == name {
+				resource = &ResourcesModel{
+					Name:             node.Name,
+					ID:               node.ResourceId,
+					Scanners:         toProvisionedScanners(node.Scanners),
+					Policy:           node.Policy.PolicyId,
+					PolicyAssignment: node.Policy.Assignment,
+				}
+				// The search may match other resources, the remaining pages are not needed.
+				return &ConnectionDataPageInfo{}, nil
+			}
+		}
+
+		return &result.Provider.Collection.Resources.PageInfo, nil
+	})
+	if err != nil || resource != nil {
+		return resource, err
+	}
+
+	tflog.Debug(ctx, "Resource not matched by search, listing resources", map[string]any{"resource": name})
+	resources, err := c.getCollection(ctx, providerId, collectionId, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resources {
+		if resources[i].Name == name {
+			return &resources[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -1,6 +1,9 @@
 package boostsecurity
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ProvidersModel struct {
 	Providers []ProviderModel
@@ -12,19 +15,84 @@ type ProviderModel struct {
 	Organizations []OrganizationModel
 }
 
+type ProviderStatsModel struct {
+	ID                 string
+	Name               string
+	TotalNeedAttention int
+	TotalResources     int
+	TotalCollections   int
+}
+
 type OrganizationModel struct {
-	Name      string
-	ID        string
-	Scanners  []string
-	Policy    string
-	Resources []ResourcesModel
+	Name             string
+	ID               string
+	AssetType        AssetType
+	BaseURL          string
+	WebURL           string
+	IconURL          string
+	Scanners         []ProvisionedScannerModel
+	Policy           string
+	PolicyAssignment PolicyAssignment
+	Resources        []ResourcesModel
 }
 
 type ResourcesModel struct {
-	Name     string
-	ID       string
-	Scanners []string
-	Policy   string
+	Name             string
+	ID               string
+	Scanners         []ProvisionedScannerModel
+	Policy           string
+	PolicyAssignment PolicyAssignment
+}
+
+type ProvisionedScannerModel struct {
+	ID                 string
+	Ruleset            string
+	ProvisioningMethod ProvisioningMethod
+}
+
+type ProvisionPlanModel struct {
+	TotalSelectedCollections int
+	TotalSelectedResources   int
+	Scanners                 []ProvisionPlanScannerModel
+}
+
+type ProvisionPlanScannerModel struct {
+	ID                         string
+	Name                       string
+	Categories                 []SecurityCategory
+	Availability               ProvisionPlanScannerAvailability
+	Targets                    []ProvisionPlanTarget
+	TotalApplicableCollections int
+	TotalApplicableResources   int
+	RulesetRequired            bool
+	Rulesets                   []string
+}
+
+type PolicyModel struct {
+	ID               string
+	Name             string
+	TotalAccounts    int
+	TotalCollections int
+	TotalResources   int
+}
+
+type ScannerConfigModel struct {
+	ID            string
+	InUse         bool
+	GlobalConfigs map[string]string
+	Rulesets      []ScannerRulesetModel
+}
+
+type ScannerRulesetModel struct {
+	ID      uuid.UUID
+	Name    string
+	InUse   bool
+	Configs map[string]string
+}
+
+type AutoAssignmentModel struct {
+	ID      string
+	Enabled bool
 }
 
 type State struct {
@@ -32,11 +100,128 @@ type State struct {
 }
 
 type AssetModel struct {
-	Provider       types.String `tfsdk:"provider"`
-	Collection     types.String `tfsdk:"collection"`
-	Resource       types.String `tfsdk:"resource"`
-	ID             types.String `tfsdk:"id"`
-	Scanners       types.List   `tfsdk:"scanners"`
-	Policy         types.String `tfsdk:"policy"`
-	AssignedPolicy types.String `tfsdk:"assigned_policy"`
+	Provider         types.String `tfsdk:"provider"`
+	Collection       types.String `tfsdk:"collection"`
+	Resource         types.String `tfsdk:"resource"`
+	ID               types.String `tfsdk:"id"`
+	Scanners         types.Set    `tfsdk:"scanners"`
+	Policy           types.String `tfsdk:"policy"`
+	AssignedPolicy   types.String `tfsdk:"assigned_policy"`
+	AssignedRulesets types.Map    `tfsdk:"assigned_rulesets"`
+}
+
+type ScannerModel struct {
+	ID      types.String `tfsdk:"id"`
+	Ruleset types.String `tfsdk:"ruleset"`
+}
+
+type ScannerConfigState struct {
+	ID            types.String `tfsdk:"id"`
+	AnalyzerID    types.String `tfsdk:"analyzer_id"`
+	GlobalConfigs types.Map    `tfsdk:"global_configs"`
+	InUse         types.Bool   `tfsdk:"in_use"`
+}
+
+type ScannerRulesetState struct {
+	ID           types.String `tfsdk:"id"`
+	AnalyzerID   types.String `tfsdk:"analyzer_id"`
+	Name         types.String `tfsdk:"name"`
+	Configs      types.Map    `tfsdk:"configs"`
+	InUse        types.Bool   `tfsdk:"in_use"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+}
+
+type AccountAutoAssignmentState struct {
+	ID          types.String `tfsdk:"id"`
+	AnalyzerIDs types.Set    `tfsdk:"analyzer_ids"`
+}
+
+type AnalyzerAutoAssignmentState struct {
+	ID           types.String `tfsdk:"id"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	AnalyzerID   types.String `tfsdk:"analyzer_id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+type ProvidersDataSourceState struct {
+	Providers []ProviderDataModel `tfsdk:"providers"`
+}
+
+type ProviderDataModel struct {
+	ProviderID         types.String `tfsdk:"provider_id"`
+	Name               types.String `tfsdk:"name"`
+	TotalNeedAttention types.Int64  `tfsdk:"total_need_attention"`
+	TotalResources     types.Int64  `tfsdk:"total_resources"`
+	TotalCollections   types.Int64  `tfsdk:"total_collections"`
+}
+
+type CollectionsDataSourceState struct {
+	ProviderName types.String          `tfsdk:"provider_name"`
+	NameRegex    types.String          `tfsdk:"name_regex"`
+	Search       types.String          `tfsdk:"search"`
+	Collections  []CollectionDataModel `tfsdk:"collections"`
+}
+
+type CollectionDataModel struct {
+	CollectionID types.String `tfsdk:"collection_id"`
+	Name         types.String `tfsdk:"name"`
+	AssetType    types.String `tfsdk:"asset_type"`
+	BaseURL      types.String `tfsdk:"base_url"`
+	WebURL       types.String `tfsdk:"web_url"`
+	IconURL      types.String `tfsdk:"icon_url"`
+	Policy       types.String `tfsdk:"policy"`
+	Scanners     types.Set    `tfsdk:"scanners"`
+}
+
+type ResourcesDataSourceState struct {
+	ProviderName types.String        `tfsdk:"provider_name"`
+	Filters      *FiltersModel       `tfsdk:"filters"`
+	Resources    []ResourceDataModel `tfsdk:"resources"`
+}
+
+type FiltersModel struct {
+	Collections          types.Set    `tfsdk:"collections"`
+	MissingCoverages     types.Set    `tfsdk:"missing_coverages"`
+	ResourceAttributes   types.Set    `tfsdk:"resource_attributes"`
+	Policies             types.Set    `tfsdk:"policies"`
+	ProvisionedAnalyzers types.Set    `tfsdk:"provisioned_analyzers"`
+	Search               types.String `tfsdk:"search"`
+}
+
+type ResourceDataModel struct {
+	ResourceID   types.String `tfsdk:"resource_id"`
+	Name         types.String `tfsdk:"name"`
+	CollectionID types.String `tfsdk:"collection_id"`
+	Collection   types.String `tfsdk:"collection"`
+	Policy       types.String `tfsdk:"policy"`
+	Scanners     types.Set    `tfsdk:"scanners"`
+}
+
+type ProvisionPlanDataSourceState struct {
+	AssetType                types.String                `tfsdk:"asset_type"`
+	AssetIDs                 types.Set                   `tfsdk:"asset_ids"`
+	TotalSelectedCollections types.Int64                 `tfsdk:"total_selected_collections"`
+	TotalSelectedResources   types.Int64                 `tfsdk:"total_selected_resources"`
+	Scanners                 []ProvisionPlanScannerState `tfsdk:"scanners"`
+}
+
+type ProvisionPlanScannerState struct {
+	ScannerID                  types.String `tfsdk:"scanner_id"`
+	ScannerName                types.String `tfsdk:"scanner_name"`
+	Categories                 types.List   `tfsdk:"categories"`
+	Availability               types.String `tfsdk:"availability"`
+	Targets                    types.List   `tfsdk:"targets"`
+	TotalApplicableCollections types.Int64  `tfsdk:"total_applicable_collections"`
+	TotalApplicableResources   types.Int64  `tfsdk:"total_applicable_resources"`
+	RulesetRequired            types.Bool   `tfsdk:"ruleset_required"`
+	Rulesets                   types.List   `tfsdk:"rulesets"`
+}
+
+type PolicyDataSourceState struct {
+	Name             types.String `tfsdk:"name"`
+	ID               types.String `tfsdk:"id"`
+	Source           types.String `tfsdk:"source"`
+	TotalAccounts    types.Int64  `tfsdk:"total_accounts"`
+	TotalCollections types.Int64  `tfsdk:"total_collections"`
+	TotalResources   types.Int64  `tfsdk:"total_resources"`
 }
@@ -0,0 +1,25 @@
+package boostsecurity
+
+import "errors"
+
+// DefaultPageSize is the number of items requested per page when no page size is configured.
+const DefaultPageSize = 100
+
+// paginate calls fetch with the cursor of each page of a connection, starting with an empty cursor,
+// until fetch reports there is no next page.
+func paginate(fetch func(after string) (*ConnectionDataPageInfo, error)) error {
+	after := ""
+	for {
+		pageInfo, err := fetch(after)
+		if err != nil {
+			return err
+		}
+		if !pageInfo.HasNextPage {
+			return nil
+		}
+		if pageInfo.EndCursor == "" || pageInfo.EndCursor == after {
+			return errors.New("pagination cursor did not advance")
+		}
+		after = pageInfo.EndCursor
+	}
+}
@@ -0,0 +1,68 @@
+package boostsecurity
+
+import (
+	"context"
+	"fmt"
+)
+
+// FindPolicies resolves the policies offered by the policy filter whose display name is name, with their assignment
+// totals. Several policies may share a name, none of them is returned when no policy matches.
+func (c *Client) FindPolicies(ctx context.Context, name string) ([]PolicyModel, error) {
+	result, err := Policies(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting policies %w", err)
+	}
+
+	policies := make([]PolicyModel, 0)
+	for _, policy := range result.SecurityPosture.Filters.Policy {
+		if policy.Display.Name == name {
+			policies = append(policies, PolicyModel{ID: policy.Value, Name: policy.Display.Name})
+		}
+	}
+	if len(policies) == 0 {
+		return policies, nil
+	}
+
+	summary, err := PolicyAssignmentSummary(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting policy assignment summary %w", err)
+	}
+	for i := range policies {
+		for _, stats := range summary.PolicyAssignmentSummary.Policies {
+			if stats.Id == policies[i].ID {
+				policies[i].TotalAccounts = stats.TotalAccounts
+				policies[i].TotalCollections = stats.TotalCollections
+				policies[i].TotalResources = stats.TotalResources
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+// GetPolicySource returns the source of a policy, read from an asset the policy is assigned to. It returns an
+// empty source when the policy is not assigned to any asset.
+func (c *Client) GetPolicySource(ctx context.Context, policyId string) (PolicySource, error) {
+	result, err := PolicyAssets(ctx, *c.client, policyId)
+	if err != nil {
+		return "", fmt.Errorf("error getting policy assets %w", err)
+	}
+
+	if result.SecurityPosture.Account.Policy.PolicyId == policyId {
+		return result.SecurityPosture.Account.Policy.Source, nil
+	}
+	for _, provider := range result.SecurityPosture.Providers.Edges {
+		for _, collection := range provider.Node.Collections.Edges {
+			if collection.Node.Policy.PolicyId == policyId {
+				return collection.Node.Policy.Source, nil
+			}
+			for _, resource := range collection.Node.Resources.Edges {
+				if resource.Node.Policy.PolicyId == policyId {
+					return resource.Node.Policy.Source, nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
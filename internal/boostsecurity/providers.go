@@ -0,0 +1,36 @@
+package boostsecurity
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetProviders returns the connected providers with their stats, without loading their collections.
+func (c *Client) GetProviders(ctx context.Context) ([]ProviderStatsModel, error) {
+	result, err := SecurityPosture(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error in SecurityPosture %w", err)
+	}
+
+	if result.SecurityPosture.Providers.PageInfo.HasNextPage {
+		// The providers connection does not accept pagination arguments.
+		tflog.Warn(ctx, "Providers are paginated by the API, only the first page is loaded", map[string]any{
+			"total_count": result.SecurityPosture.Providers.TotalCount,
+		})
+	}
+
+	providers := make([]ProviderStatsModel, 0)
+	for _, item := range result.SecurityPosture.Providers.Edges {
+		node := item.Node
+		providers = append(providers, ProviderStatsModel{
+			ID:                 node.ProviderId,
+			Name:               node.Name,
+			TotalNeedAttention: node.Stats.TotalNeedAttention,
+			TotalResources:     node.Stats.TotalResources,
+			TotalCollections:   node.Collections.TotalCount,
+		})
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,70 @@
+package boostsecurity
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket refilled at a constant rate, up to burst tokens.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token, blocking until one is available or the context is cancelled.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// The token is reserved right away, callers queue up behind the missing tokens.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mutex.Lock()
+		l.tokens++
+		l.mutex.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitedDoer waits for the rate limiter before sending each request, retries included.
+type rateLimitedDoer struct {
+	client  Doer
+	limiter *rateLimiter
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return d.client.Do(req)
+}
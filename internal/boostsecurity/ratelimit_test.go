@@ -0,0 +1,78 @@
+package boostsecurity
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newRateLimiter(1, 3)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("got %s for the burst, want no wait", elapsed)
+	}
+}
+
+func TestRateLimiterRate(t *testing.T) {
+	limiter := newRateLimiter(50, 1)
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first request takes the burst, the 5 others wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("got %s for 6 requests at 50 per second, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	limiter := newRateLimiter(0.1, 1)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The token reserved by the cancelled wait is given back, the next caller does not queue behind it.
+	limiter.mutex.Lock()
+	tokens := limiter.tokens
+	limiter.mutex.Unlock()
+	if tokens < -0.1 || tokens > 0.1 {
+		t.Errorf("got %f tokens after the cancelled wait, want 0", tokens)
+	}
+}
+
+func TestRateLimitedDoer(t *testing.T) {
+	doer, bodies := scriptedDoer(status(http.StatusOK))
+	limited := &rateLimitedDoer{client: doer, limiter: newRateLimiter(0.1, 1)}
+
+	resp, err := limited.Do(graphqlRequest(t, "query Q { a }"))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("got %v, %v, want status 200", resp, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := limited.Do(graphqlRequest(t, "query Q { a }").WithContext(ctx)); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if len(*bodies) != 1 {
+		t.Errorf("got %d requests sent, want 1", len(*bodies))
+	}
+}
@@ -0,0 +1,166 @@
+package boostsecurity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried when none is configured.
+	DefaultMaxRetries = 4
+	// DefaultRetryWaitMin is the minimum time waited before retrying a request when none is configured.
+	DefaultRetryWaitMin = 1 * time.Second
+	// DefaultRetryWaitMax is the maximum time waited before retrying a request when none is configured.
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// retryPolicy configures how failed requests are retried.
+type retryPolicy struct {
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+// retryDoer retries the requests failing with a transient error. Queries are retried on transport errors,
+// throttling and server errors. Mutations are not idempotent, they are only retried when the connection
+// could not be established, since the request was never sent.
+type retryDoer struct {
+	client Doer
+	policy retryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	mutation := isMutation(body)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+
+		resp, err := d.client.Do(attemptReq)
+		if attempt >= d.policy.maxRetries || !d.shouldRetry(req.Context(), resp, err, mutation) {
+			return resp, err
+		}
+
+		wait := d.backoff(attempt, resp)
+		fields := map[string]any{"attempt": attempt + 1, "wait": wait.String()}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		tflog.Debug(req.Context(), "Retrying request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (d *retryDoer) shouldRetry(ctx context.Context, resp *http.Response, err error, mutation bool) bool {
+	if err != nil {
+		// The timeout of the HTTP client also matches context.DeadlineExceeded, only the context of the request
+		// tells if the caller gave up.
+		if ctx.Err() != nil {
+			return false
+		}
+		if mutation {
+			return isDialError(err)
+		}
+		return true
+	}
+	if mutation {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		(resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented)
+}
+
+// backoff returns the time to wait before the next attempt: the Retry-After of the response when set,
+// otherwise a random wait between the minimum and an exponential backoff. The wait is capped to the maximum.
+func (d *retryDoer) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, d.policy.waitMax)
+		}
+	}
+
+	wait := d.policy.waitMin
+	for i := 0; i < attempt && wait < d.policy.waitMax; i++ {
+		wait *= 2
+	}
+	wait = min(wait, d.policy.waitMax)
+	if wait <= d.policy.waitMin {
+		return d.policy.waitMin
+	}
+
+	return d.policy.waitMin + time.Duration(rand.Int63n(int64(wait-d.policy.waitMin)+1))
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	return io.ReadAll(req.Body)
+}
+
+// isMutation tells if the body of a GraphQL request holds a mutation.
+func isMutation(body []byte) bool {
+	var request struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		// Not a GraphQL request we can inspect, it is not safe to retry it.
+		return true
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(request.Query), "mutation")
+}
+
+// isDialError tells if the connection to the API could not be established.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr)
+}
@@ -0,0 +1,241 @@
+package boostsecurity
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// doerFunc adapts a function to the Doer interface.
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func graphqlRequest(t *testing.T, query string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "https://api.example.com/graphql", strings.NewReader(`{"query":"`+query+`"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// scriptedDoer answers with the given outcomes in turn and records the bodies it received.
+func scriptedDoer(outcomes ...func() (*http.Response, error)) (Doer, *[]string) {
+	bodies := make([]string, 0)
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		outcome := outcomes[min(len(bodies), len(outcomes))-1]
+		return outcome()
+	}), &bodies
+}
+
+func status(code int) func() (*http.Response, error) {
+	return func() (*http.Response, error) { return response(code, nil), nil }
+}
+
+func failure(err error) func() (*http.Response, error) {
+	return func() (*http.Response, error) { return nil, err }
+}
+
+func outcomes(outcomes ...func() (*http.Response, error)) []func() (*http.Response, error) {
+	return outcomes
+}
+
+var dialError = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+func TestRetryDoer(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		maxRetries int
+		outcomes   []func() (*http.Response, error)
+		attempts   int
+		status     int
+		err        bool
+	}{
+		{name: "success", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusOK)), attempts: 1, status: http.StatusOK},
+		{name: "server error", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusBadGateway), status(http.StatusOK)), attempts: 2, status: http.StatusOK},
+		{name: "throttled", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusTooManyRequests), status(http.StatusOK)), attempts: 2, status: http.StatusOK},
+		{name: "transport error", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(failure(errors.New("connection reset")), status(http.StatusOK)), attempts: 2, status: http.StatusOK},
+		{name: "not implemented", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusNotImplemented)), attempts: 1, status: http.StatusNotImplemented},
+		{name: "client error", query: "query Q { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusBadRequest)), attempts: 1, status: http.StatusBadRequest},
+		{name: "retries exhausted", query: "query Q { a }", maxRetries: 2, outcomes: outcomes(status(http.StatusServiceUnavailable)), attempts: 3, status: http.StatusServiceUnavailable},
+		{name: "retries disabled", query: "query Q { a }", maxRetries: 0, outcomes: outcomes(status(http.StatusServiceUnavailable)), attempts: 1, status: http.StatusServiceUnavailable},
+		{name: "mutation server error", query: "mutation M { a }", maxRetries: 3, outcomes: outcomes(status(http.StatusServiceUnavailable)), attempts: 1, status: http.StatusServiceUnavailable},
+		{name: "mutation transport error", query: "mutation M { a }", maxRetries: 3, outcomes: outcomes(failure(errors.New("connection reset"))), attempts: 1, err: true},
+		{name: "mutation dial error", query: "mutation M { a }", maxRetries: 3, outcomes: outcomes(failure(dialError), status(http.StatusOK)), attempts: 2, status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doer, bodies := scriptedDoer(test.outcomes...)
+			retry := &retryDoer{client: doer, policy: retryPolicy{maxRetries: test.maxRetries, waitMin: time.Millisecond, waitMax: time.Millisecond}}
+
+			resp, err := retry.Do(graphqlRequest(t, test.query))
+			if test.err != (err != nil) {
+				t.Fatalf("got error %v, want error %t", err, test.err)
+			}
+			if err == nil && resp.StatusCode != test.status {
+				t.Errorf("got status %d, want %d", resp.StatusCode, test.status)
+			}
+			if len(*bodies) != test.attempts {
+				t.Errorf("got %d attempts, want %d", len(*bodies), test.attempts)
+			}
+			for _, body := range *bodies {
+				if body != (*bodies)[0] {
+					t.Errorf("got body %q on retry, want %q", body, (*bodies)[0])
+				}
+			}
+		})
+	}
+}
+
+func TestRetryDoerContextCanceledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	doer, bodies := scriptedDoer(func() (*http.Response, error) {
+		cancel()
+		return response(http.StatusServiceUnavailable, nil), nil
+	})
+	retry := &retryDoer{client: doer, policy: retryPolicy{maxRetries: 3, waitMin: time.Minute, waitMax: time.Minute}}
+
+	_, err := retry.Do(graphqlRequest(t, "query Q { a }").WithContext(ctx))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if len(*bodies) != 1 {
+		t.Errorf("got %d attempts, want 1", len(*bodies))
+	}
+}
+
+func TestRetryDoerContextCanceledDuringAttempt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	doer, bodies := scriptedDoer(func() (*http.Response, error) {
+		cancel()
+		return nil, context.Canceled
+	})
+	retry := &retryDoer{client: doer, policy: retryPolicy{maxRetries: 3, waitMin: time.Millisecond, waitMax: time.Millisecond}}
+
+	if _, err := retry.Do(graphqlRequest(t, "query Q { a }").WithContext(ctx)); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if len(*bodies) != 1 {
+		t.Errorf("got %d attempts, want 1", len(*bodies))
+	}
+}
+
+func TestRetryDoerClientTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(200 * time.Millisecond):
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The timeout of the client bounds a single attempt, a query timing out is retried.
+	retry := &retryDoer{client: &http.Client{Timeout: 50 * time.Millisecond}, policy: retryPolicy{maxRetries: 3, waitMin: time.Millisecond, waitMax: time.Millisecond}}
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"query":"query Q { a }"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := retry.Do(req)
+	if err != nil {
+		t.Fatalf("got error %v, want the query retried after the timeout", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || attempts.Load() != 2 {
+		t.Errorf("got status %d after %d attempts, want 200 after 2", resp.StatusCode, attempts.Load())
+	}
+}
+
+func TestRetryDoerBackoff(t *testing.T) {
+	retry := &retryDoer{policy: retryPolicy{maxRetries: 10, waitMin: time.Second, waitMax: 10 * time.Second}}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := retry.backoff(attempt, nil)
+		ceiling := min(time.Second<<attempt, 10*time.Second)
+		if wait < time.Second || wait > ceiling {
+			t.Errorf("attempt %d: got wait %s, want between 1s and %s", attempt, wait, ceiling)
+		}
+	}
+
+	tests := []struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		{retryAfter: "3", want: 3 * time.Second},
+		{retryAfter: "0", want: 0},
+		{retryAfter: "3600", want: 10 * time.Second},
+		{retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), want: 10 * time.Second},
+		{retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0},
+	}
+	for _, test := range tests {
+		wait := retry.backoff(0, response(http.StatusTooManyRequests, http.Header{"Retry-After": []string{test.retryAfter}}))
+		if wait != test.want {
+			t.Errorf("Retry-After %q: got wait %s, want %s", test.retryAfter, wait, test.want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "5", want: 5 * time.Second, ok: true},
+		{value: "-5", ok: false},
+		{value: "soon", ok: false},
+		{value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), want: 0, ok: true},
+	}
+	for _, test := range tests {
+		got, ok := retryAfter(test.value)
+		if ok != test.ok || got != test.want {
+			t.Errorf("retryAfter(%q): got %s, %t, want %s, %t", test.value, got, ok, test.want, test.ok)
+		}
+	}
+
+	got, ok := retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if !ok || got <= 0 || got > time.Minute {
+		t.Errorf("retryAfter of a future date: got %s, %t, want up to 1m, true", got, ok)
+	}
+}
+
+func TestIsMutation(t *testing.T) {
+	tests := []struct {
+		body string
+		want bool
+	}{
+		{body: `{"query":"query Q { a }"}`, want: false},
+		{body: `{"query":"  mutation M { a }"}`, want: true},
+		{body: `not json`, want: true},
+	}
+	for _, test := range tests {
+		if got := isMutation([]byte(test.body)); got != test.want {
+			t.Errorf("isMutation(%s): got %t, want %t", test.body, got, test.want)
+		}
+	}
+}
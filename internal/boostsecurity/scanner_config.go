@@ -0,0 +1,177 @@
+package boostsecurity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+)
+
+// ErrScannerConfigUnchanged is returned by the update of UpdateScannerConfig to leave the configuration as is,
+// no mutation is sent.
+var ErrScannerConfigUnchanged = errors.New("scanner config unchanged")
+
+// GetScannerConfig returns the configuration of an analyzer, or nil when the analyzer has no configuration.
+func (c *Client) GetScannerConfig(ctx context.Context, analyzerId string) (*ScannerConfigModel, error) {
+	result, err := ScannerConfigs(ctx, *c.client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scanner configs %w", err)
+	}
+
+	for _, config := range result.ScannerConfigs.Configs {
+		if config.Id == analyzerId {
+			return toScannerConfigModel(&config.scannerConfigData), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateScannerConfig reads the current configuration of an analyzer, lets update modify it and writes it back.
+// The whole configuration is always sent so that global configs and rulesets managed separately are preserved.
+// When update returns ErrScannerConfigUnchanged, nothing is written and the current configuration is returned,
+// nil when the analyzer has none.
+func (c *Client) UpdateScannerConfig(ctx context.Context, analyzerId string, update func(config *ScannerConfigModel) error) (*ScannerConfigModel, error) {
+	c.scannerConfigMutex.Lock()
+	defer c.scannerConfigMutex.Unlock()
+
+	return c.updateScannerConfig(ctx, analyzerId, update)
+}
+
+// updateScannerConfig implements UpdateScannerConfig, it must be called with the scanner config mutex held.
+func (c *Client) updateScannerConfig(ctx context.Context, analyzerId string, update func(config *ScannerConfigModel) error) (*ScannerConfigModel, error) {
+	existing, err := c.GetScannerConfig(ctx, analyzerId)
+	if err != nil {
+		return nil, err
+	}
+	config := existing
+	if config == nil {
+		config = &ScannerConfigModel{ID: analyzerId, GlobalConfigs: map[string]string{}}
+	}
+
+	err = update(config)
+	if errors.Is(err, ErrScannerConfigUnchanged) {
+		// Sending the configuration would create it when the analyzer has none.
+		return existing, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := UpdateScannerConfigs(ctx, *c.client, toScannerConfigUpdate(config))
+	if err != nil {
+		return nil, err
+	}
+
+	switch response := res.UpdateScannerConfigs.(type) {
+	case *UpdateScannerConfigsUpdateScannerConfigsScannerConfig:
+		return toScannerConfigModel(&response.scannerConfigData), nil
+	case *UpdateScannerConfigsUpdateScannerConfigsOperationError:
+		return nil, newOperationError(response)
+	}
+
+	return nil, fmt.Errorf("unexpected response type %s", res.UpdateScannerConfigs.GetTypename())
+}
+
+// ClearScannerGlobalConfigs removes the global configs of an analyzer. Rulesets are managed separately, the whole
+// configuration is only removed when it has none. The check and the removal happen under the scanner config mutex,
+// so that a ruleset created concurrently is not removed along with the configuration.
+func (c *Client) ClearScannerGlobalConfigs(ctx context.Context, analyzerId string) error {
+	c.scannerConfigMutex.Lock()
+	defer c.scannerConfigMutex.Unlock()
+
+	config, err := c.GetScannerConfig(ctx, analyzerId)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return nil
+	}
+
+	if len(config.Rulesets) > 0 {
+		_, err = c.updateScannerConfig(ctx, analyzerId, func(config *ScannerConfigModel) error {
+			config.GlobalConfigs = map[string]string{}
+			return nil
+		})
+		return err
+	}
+
+	return c.removeScannerConfig(ctx, analyzerId)
+}
+
+// removeScannerConfig removes the whole configuration of an analyzer, including its rulesets. It must be called
+// with the scanner config mutex held.
+func (c *Client) removeScannerConfig(ctx context.Context, analyzerId string) error {
+	res, err := RemoveScannerConfigs(ctx, *c.client, analyzerId)
+	if err != nil {
+		return err
+	}
+
+	if response, ok := res.RemoveScannerConfigs.(*RemoveScannerConfigsRemoveScannerConfigsOperationError); ok {
+		return newOperationError(response)
+	}
+
+	return nil
+}
+
+func toScannerConfigModel(data *scannerConfigData) *ScannerConfigModel {
+	globalConfigs := make(map[string]string)
+	for _, mapping := range data.GlobalConfigs {
+		globalConfigs[mapping.Id] = mapping.Value
+	}
+
+	rulesets := make([]ScannerRulesetModel, 0)
+	for _, ruleset := range data.Rulesets {
+		configs := make(map[string]string)
+		for _, mapping := range ruleset.Configs {
+			configs[mapping.Id] = mapping.Value
+		}
+		rulesets = append(rulesets, ScannerRulesetModel{
+			ID:      ruleset.Id,
+			Name:    ruleset.Name,
+			InUse:   ruleset.InUse,
+			Configs: configs,
+		})
+	}
+
+	return &ScannerConfigModel{
+		ID:            data.Id,
+		InUse:         data.InUse,
+		GlobalConfigs: globalConfigs,
+		Rulesets:      rulesets,
+	}
+}
+
+func toScannerConfigUpdate(config *ScannerConfigModel) ScannerConfigUpdate {
+	rulesets := make([]ScannerConfigRulesetInput, 0)
+	for _, ruleset := range config.Rulesets {
+		input := ScannerConfigRulesetInput{
+			Name:    ruleset.Name,
+			Configs: toScannerConfigMappings(ruleset.Configs),
+		}
+		if ruleset.ID != uuid.Nil {
+			id := ruleset.ID
+			input.Id = &id
+		}
+		rulesets = append(rulesets, input)
+	}
+
+	return ScannerConfigUpdate{
+		Id:            config.ID,
+		GlobalConfigs: toScannerConfigMappings(config.GlobalConfigs),
+		Rulesets:      rulesets,
+	}
+}
+
+func toScannerConfigMappings(configs map[string]string) []ScannerConfigMappingInput {
+	mappings := make([]ScannerConfigMappingInput, 0)
+	for id, value := range configs {
+		mappings = append(mappings, ScannerConfigMappingInput{Id: id, Value: value})
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].Id < mappings[j].Id
+	})
+
+	return mappings
+}
@@ -0,0 +1,43 @@
+package boostsecurity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateScannerConfigUnchanged(t *testing.T) {
+	operations := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			OperationName string `json:"operationName"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		operations[request.OperationName]++
+		if request.OperationName != "ScannerConfigs" {
+			http.Error(w, "unexpected operation "+request.OperationName, http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"scannerConfigs":{"configs":[]}}}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, StaticToken("token"), WithRetries(0, 0, 0))
+
+	config, err := client.UpdateScannerConfig(context.Background(), "analyzer", func(config *ScannerConfigModel) error {
+		return ErrScannerConfigUnchanged
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config != nil {
+		t.Errorf("got config %v, want none for an analyzer without configuration", config)
+	}
+	if operations["UpdateScannerConfigs"] != 0 {
+		t.Errorf("got %d updates, want none when the configuration is unchanged", operations["UpdateScannerConfigs"])
+	}
+}
@@ -0,0 +1,96 @@
+package boostsecurity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// TokenSource provides the API token sent with each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// staticToken is a token set in the configuration.
+type staticToken string
+
+func (t staticToken) Token(_ context.Context) (string, error) {
+	return string(t), nil
+}
+
+// StaticToken returns a TokenSource always providing token.
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+// cachedTokenSource caches the token fetched from an external source until the API rejects it.
+type cachedTokenSource struct {
+	fetch func(ctx context.Context) (string, error)
+
+	mutex sync.Mutex
+	token string
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" {
+		return s.token, nil
+	}
+
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", errors.New("the token source returned an empty token")
+	}
+	s.token = token
+
+	return token, nil
+}
+
+// invalidate drops the cached token, the next request fetches it again.
+func (s *cachedTokenSource) invalidate(rejected string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Another request may already have refreshed the token.
+	if s.token == rejected {
+		s.token = ""
+	}
+}
+
+// FileTokenSource returns a TokenSource reading the token from a file. The file is read again when the API
+// rejects the token, so that it can be rotated while the provider runs.
+func FileTokenSource(name string) TokenSource {
+	return &cachedTokenSource{fetch: func(_ context.Context) (string, error) {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("error reading token file %w", err)
+		}
+		return string(content), nil
+	}}
+}
+
+// CommandTokenSource returns a TokenSource running a credential helper and reading the token from its standard
+// output. The command runs again when the API rejects the token.
+func CommandTokenSource(command []string) TokenSource {
+	return &cachedTokenSource{fetch: func(ctx context.Context) (string, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("error running token command %s: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return stdout.String(), nil
+	}}
+}
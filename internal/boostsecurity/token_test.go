@@ -0,0 +1,118 @@
+package boostsecurity
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeToken(t *testing.T, name string, token string) {
+	if err := os.WriteFile(name, []byte(token), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStaticToken(t *testing.T) {
+	token, err := StaticToken("secret").Token(context.Background())
+	if err != nil || token != "secret" {
+		t.Errorf("got %q, %v, want secret", token, err)
+	}
+}
+
+func TestFileTokenSource(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "token")
+	writeToken(t, name, "first\n")
+	source := FileTokenSource(name)
+
+	token, err := source.Token(ctx)
+	if err != nil || token != "first" {
+		t.Fatalf("got %q, %v, want first", token, err)
+	}
+
+	// The token is cached until it is rejected.
+	writeToken(t, name, "second\n")
+	if token, _ := source.Token(ctx); token != "first" {
+		t.Errorf("got %q before invalidation, want first", token)
+	}
+
+	// A rejection of a token that was already refreshed keeps the current one.
+	source.(*cachedTokenSource).invalidate("stale")
+	if token, _ := source.Token(ctx); token != "first" {
+		t.Errorf("got %q after invalidating another token, want first", token)
+	}
+
+	source.(*cachedTokenSource).invalidate("first")
+	if token, _ := source.Token(ctx); token != "second" {
+		t.Errorf("got %q after invalidation, want second", token)
+	}
+}
+
+func TestFileTokenSourceErrors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	if _, err := FileTokenSource(filepath.Join(dir, "missing")).Token(ctx); err == nil {
+		t.Error("got no error for a missing file")
+	}
+
+	empty := filepath.Join(dir, "empty")
+	writeToken(t, empty, " \n")
+	if _, err := FileTokenSource(empty).Token(ctx); err == nil {
+		t.Error("got no error for an empty token")
+	}
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	token, err := CommandTokenSource([]string{"sh", "-c", "echo secret"}).Token(ctx)
+	if err != nil || token != "secret" {
+		t.Errorf("got %q, %v, want secret", token, err)
+	}
+
+	_, err = CommandTokenSource([]string{"sh", "-c", "echo denied >&2; exit 1"}).Token(ctx)
+	if err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("got error %v, want the standard error of the command", err)
+	}
+}
+
+func TestClientWithHeaderRefreshesRejectedToken(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "token")
+	writeToken(t, name, "first")
+	source := FileTokenSource(name)
+
+	authorizations := make([]string, 0)
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		if req.Header.Get("Authorization") != "ApiKey second" {
+			return response(http.StatusUnauthorized, nil), nil
+		}
+		return response(http.StatusOK, nil), nil
+	})
+	client := &clientWithHeader{client: doer, token: source}
+
+	// The first token is fetched, then rotated before it is rejected.
+	if _, err := source.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	writeToken(t, name, "second")
+
+	resp, err := client.Do(graphqlRequest(t, "query Q { a }"))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("got %v, %v, want status 200", resp, err)
+	}
+	if strings.Join(authorizations, ", ") != "ApiKey first, ApiKey second" {
+		t.Errorf("got authorizations %v, want the rejected then the refreshed token", authorizations)
+	}
+
+	// A static token cannot be refreshed, its rejection is returned as is.
+	authorizations = authorizations[:0]
+	resp, err = (&clientWithHeader{client: doer, token: StaticToken("first")}).Do(graphqlRequest(t, "query Q { a }"))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || len(authorizations) != 1 {
+		t.Errorf("got %v, %v after %d attempts, want a single rejected attempt", resp, err, len(authorizations))
+	}
+}
@@ -0,0 +1,67 @@
+package boostsecurity
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultRequestTimeout is the time allowed for a single request when none is configured.
+const DefaultRequestTimeout = 60 * time.Second
+
+// TransportConfig configures the HTTP client used to reach the API.
+type TransportConfig struct {
+	// Timeout bounds each request, retries excluded.
+	Timeout time.Duration
+	// ProxyURL is the proxy used for every request, the proxy environment variables are used when empty.
+	ProxyURL string
+	// CACertPEM holds additional certificate authorities to trust, on top of the system ones.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM hold the client certificate presented for mutual TLS.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables the verification of the API certificate.
+	InsecureSkipVerify bool
+}
+
+// NewHTTPClient builds an HTTP client from config.
+func NewHTTPClient(config TransportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if len(config.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, errors.New("no valid certificate found in the CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(config.ClientCertPEM) > 0 || len(config.ClientKeyPEM) > 0 {
+		certificate, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{Transport: transport, Timeout: config.Timeout}, nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// accountAutoAssignmentId is the ID of the account auto assignment, there is only one per account.
+const accountAutoAssignmentId = "account"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &accountAutoAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &accountAutoAssignmentResource{}
+	_ resource.ResourceWithImportState = &accountAutoAssignmentResource{}
+)
+
+// NewAccountAutoAssignmentResource is a helper function to simplify the provider implementation.
+func NewAccountAutoAssignmentResource() resource.Resource {
+	return &accountAutoAssignmentResource{}
+}
+
+// accountAutoAssignmentResource is the resource implementation.
+type accountAutoAssignmentResource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the resource type name.
+func (r *accountAutoAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_auto_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *accountAutoAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the analyzers auto-assigned to every new asset of the account.",
+		MarkdownDescription: "Manages the analyzers auto-assigned to every new asset of the account. \n " +
+			"There is a single auto assignment per account, destroying this resource clears it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the auto assignment, always `account`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"analyzer_ids": schema.SetAttribute{
+				Description: "Set of analyzer IDs auto-assigned to every new asset.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *accountAutoAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATING")
+	var state boostsecurity.AccountAutoAssignmentState
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analyzerIds := make([]string, 0)
+	diags = state.AnalyzerIDs.ElementsAs(ctx, &analyzerIds, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateAccountAutoAssignment(ctx, analyzerIds)
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error setting account auto assignment", "Could not set account auto assignment", err)
+		return
+	}
+
+	state.ID = types.StringValue(accountAutoAssignmentId)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *accountAutoAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READING")
+	var state boostsecurity.AccountAutoAssignmentState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analyzerIds, err := r.client.GetAccountAutoAssignment(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading account auto assignment", "Could not read account auto assignment : "+err.Error())
+		return
+	}
+
+	values := make([]attr.Value, 0)
+	for _, analyzerId := range analyzerIds {
+		values = append(values, types.StringValue(analyzerId))
+	}
+
+	state.ID = types.StringValue(accountAutoAssignmentId)
+	state.AnalyzerIDs, diags = types.SetValue(types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountAutoAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedState boostsecurity.AccountAutoAssignmentState
+	diags := req.Plan.Get(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analyzerIds := make([]string, 0)
+	diags = plannedState.AnalyzerIDs.ElementsAs(ctx, &analyzerIds, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateAccountAutoAssignment(ctx, analyzerIds)
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error setting account auto assignment", "Could not set account auto assignment", err)
+		return
+	}
+
+	plannedState.ID = types.StringValue(accountAutoAssignmentId)
+
+	diags = resp.State.Set(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountAutoAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	err := r.client.UpdateAccountAutoAssignment(ctx, []string{})
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error clearing account auto assignment", "Could not clear account auto assignment", err)
+		return
+	}
+}
+
+func (r *accountAutoAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *accountAutoAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &analyzerAutoAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &analyzerAutoAssignmentResource{}
+	_ resource.ResourceWithImportState = &analyzerAutoAssignmentResource{}
+)
+
+// NewAnalyzerAutoAssignmentResource is a helper function to simplify the provider implementation.
+func NewAnalyzerAutoAssignmentResource() resource.Resource {
+	return &analyzerAutoAssignmentResource{}
+}
+
+// analyzerAutoAssignmentResource is the resource implementation.
+type analyzerAutoAssignmentResource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the resource type name.
+func (r *analyzerAutoAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analyzer_auto_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *analyzerAutoAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the auto assignment of an analyzer for a provider.",
+		MarkdownDescription: "Manages the auto assignment of an analyzer for a provider. \n " +
+			"Destroying this resource disables the auto assignment. \n " +
+			"The API sets the auto assignment of an analyzer without taking a provider, `provider_name` only selects the provider it is read from and the setting is not scoped to it. " +
+			"Manage a given analyzer with a single resource, resources for the same analyzer under different providers overwrite each other's setting.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the auto assignment, formatted as `<provider_name>/<analyzer_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_name": schema.StringAttribute{
+				Description: "The name of the provider the auto assignment is read from. The setting is not scoped to this provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"analyzer_id": schema.StringAttribute{
+				Description: "The ID of the analyzer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the analyzer is auto-assigned to new assets of the provider.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *analyzerAutoAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATING")
+	var state boostsecurity.AnalyzerAutoAssignmentState
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoAssignment, err := r.client.GetAnalyzerAutoAssignment(ctx, state.ProviderName.ValueString(), state.AnalyzerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading analyzer auto assignment", "Could not read analyzer auto assignment : "+err.Error())
+		return
+	}
+	if autoAssignment == nil {
+		resp.Diagnostics.AddError(
+			"Analyzer auto assignment not available",
+			fmt.Sprintf("Provider %s does not offer auto assignment for analyzer %s", state.ProviderName.ValueString(), state.AnalyzerID.ValueString()),
+		)
+		return
+	}
+
+	diags = r.apply(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *analyzerAutoAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READING")
+	var state boostsecurity.AnalyzerAutoAssignmentState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoAssignment, err := r.client.GetAnalyzerAutoAssignment(ctx, state.ProviderName.ValueString(), state.AnalyzerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading analyzer auto assignment", "Could not read analyzer auto assignment : "+err.Error())
+		return
+	}
+	if autoAssignment == nil {
+		tflog.Debug(ctx, "Analyzer auto assignment not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ProviderName.ValueString() + "/" + autoAssignment.ID)
+	state.Enabled = types.BoolValue(autoAssignment.Enabled)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *analyzerAutoAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedState boostsecurity.AnalyzerAutoAssignmentState
+	diags := req.Plan.Get(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *analyzerAutoAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state boostsecurity.AnalyzerAutoAssignmentState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAnalyzerAutoAssignment(ctx, state.AnalyzerID.ValueString(), false)
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error disabling analyzer auto assignment", "Could not disable analyzer auto assignment "+state.ID.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports an auto assignment using an ID formatted as `<provider_name>/<analyzer_id>`.
+func (r *analyzerAutoAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	providerName, analyzerId, found := strings.Cut(req.ID, "/")
+	if !found || providerName == "" || analyzerId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <provider_name>/<analyzer_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_name"), providerName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("analyzer_id"), analyzerId)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *analyzerAutoAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *analyzerAutoAssignmentResource) apply(ctx context.Context, state *boostsecurity.AnalyzerAutoAssignmentState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	autoAssignment, err := r.client.UpdateAnalyzerAutoAssignment(ctx, state.AnalyzerID.ValueString(), state.Enabled.ValueBool())
+	if err != nil {
+		addOperationError(&diags, "Error setting analyzer auto assignment", "Could not set analyzer auto assignment "+state.ProviderName.ValueString()+"/"+state.AnalyzerID.ValueString(), err)
+		return diags
+	}
+
+	state.ID = types.StringValue(state.ProviderName.ValueString() + "/" + autoAssignment.ID)
+	state.Enabled = types.BoolValue(autoAssignment.Enabled)
+
+	return diags
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &collectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &collectionsDataSource{}
+)
+
+// NewCollectionsDataSource is a helper function to simplify the provider implementation.
+func NewCollectionsDataSource() datasource.DataSource {
+	return &collectionsDataSource{}
+}
+
+// collectionsDataSource is the data source implementation.
+type collectionsDataSource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the data source type name.
+func (d *collectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+// Schema defines the schema for the data source.
+func (d *collectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the collections of a provider.",
+		MarkdownDescription: "Lists the collections of a provider, such as the organizations of GitHub. \n " +
+			"Use it to fortify every collection of a provider without listing each one.",
+		Attributes: map[string]schema.Attribute{
+			"provider_name": schema.StringAttribute{
+				Description: "The name of the provider of the collections.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of the collections must match.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description:         "A search term applied by the API to the collections.",
+				MarkdownDescription: "A search term applied by the API to the collections. \n It reduces the collections loaded, `name_regex` is applied on the result.",
+				Optional:            true,
+			},
+			"collections": schema.ListNestedAttribute{
+				Description: "List of the matching collections.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collection_id": schema.StringAttribute{
+							Description: "The ID of the collection.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the collection, as used by the `collection` of the fortify resource.",
+							Computed:    true,
+						},
+						"asset_type": schema.StringAttribute{
+							Description: "The asset type of the collection.",
+							Computed:    true,
+						},
+						"base_url": schema.StringAttribute{
+							Description: "The base URL of the collection.",
+							Computed:    true,
+						},
+						"web_url": schema.StringAttribute{
+							Description: "The web URL of the collection.",
+							Computed:    true,
+						},
+						"icon_url": schema.StringAttribute{
+							Description: "The icon URL of the collection.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "The ID of the policy of the collection.",
+							Computed:    true,
+						},
+						"scanners": schema.SetNestedAttribute{
+							Description: "Set of scanners provisioned on the collection.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the scanner.",
+										Computed:    true,
+									},
+									"ruleset": schema.StringAttribute{
+										Description: "The name of the ruleset of the scanner.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *collectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state boostsecurity.CollectionsDataSourceState
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name regex", "Could not compile name regex : "+err.Error())
+			return
+		}
+	}
+
+	provider, err := d.client.FindProvider(ctx, state.ProviderName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding provider", "Could not find provider : "+err.Error())
+		return
+	}
+	if provider == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("provider_name"), "Provider not found", "Could not find provider : "+state.ProviderName.ValueString())
+		return
+	}
+
+	collections, err := d.client.ListCollections(ctx, provider.ID, state.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading collections", "Could not read collections of provider "+provider.Name+" : "+err.Error())
+		return
+	}
+
+	state.Collections = make([]boostsecurity.CollectionDataModel, 0)
+	for _, collection := range collections {
+		if nameRegex != nil && !nameRegex.MatchString(collection.Name) {
+			continue
+		}
+		scanners, _ := toScannerValues(collection.Scanners)
+		state.Collections = append(state.Collections, boostsecurity.CollectionDataModel{
+			CollectionID: types.StringValue(collection.ID),
+			Name:         types.StringValue(collection.Name),
+			AssetType:    types.StringValue(string(collection.AssetType)),
+			BaseURL:      optionalString(collection.BaseURL),
+			WebURL:       optionalString(collection.WebURL),
+			IconURL:      optionalString(collection.IconURL),
+			Policy:       optionalString(collection.Policy),
+			Scanners:     scanners,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *collectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+// optionalString converts a string the API leaves empty when unset to a null value.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
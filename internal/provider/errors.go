@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// addOperationError reports the error of a mutation. The schema declares OperationError.errorType as a free-form
+// string and the API documents no values for it, so there are no known types to map to specific diagnostics:
+// operation errors are reported with their type and message verbatim. detail describes the operation and its
+// target, such as the asset path.
+func addOperationError(diags *diag.Diagnostics, summary string, detail string, err error) {
+	var operationError *boostsecurity.OperationError
+	if errors.As(err, &operationError) {
+		diags.AddError(summary, detail+" : the API rejected the operation with "+operationError.ErrorType+" : "+operationError.ErrorMessage)
+		return
+	}
+
+	diags.AddError(summary, detail+" : "+err.Error())
+}
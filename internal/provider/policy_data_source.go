@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policyDataSource{}
+	_ datasource.DataSourceWithConfigure = &policyDataSource{}
+)
+
+// NewPolicyDataSource is a helper function to simplify the provider implementation.
+func NewPolicyDataSource() datasource.DataSource {
+	return &policyDataSource{}
+}
+
+// policyDataSource is the data source implementation.
+type policyDataSource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the data source type name.
+func (d *policyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// Schema defines the schema for the data source.
+func (d *policyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves a policy by its display name.",
+		MarkdownDescription: "Resolves a policy by its display name, so that the `policy` of the fortify resource does not have to be an opaque ID. \n " +
+			"Only the policies offered by the policy filter of the Boost security posture can be resolved, and the name must match a single policy.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The display name of the policy.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the policy, as used by the `policy` of the fortify resource.",
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description:         "The source of the policy, one of `DESIGNER`, `AS_CODE` or `BUILT_IN`.",
+				MarkdownDescription: "The source of the policy, one of `DESIGNER`, `AS_CODE` or `BUILT_IN`. \n The source is read from an asset the policy is assigned to, it is null when the policy is not assigned.",
+				Computed:            true,
+			},
+			"total_accounts": schema.Int64Attribute{
+				Description: "The number of accounts the policy is assigned to.",
+				Computed:    true,
+			},
+			"total_collections": schema.Int64Attribute{
+				Description: "The number of collections the policy is assigned to.",
+				Computed:    true,
+			},
+			"total_resources": schema.Int64Attribute{
+				Description: "The number of resources the policy is assigned to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *policyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state boostsecurity.PolicyDataSourceState
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.client.FindPolicies(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding policy", "Could not find policy : "+err.Error())
+		return
+	}
+	if len(policies) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Policy not found", "Could not find policy : "+state.Name.ValueString())
+		return
+	}
+	if len(policies) > 1 {
+		policyIds := make([]string, 0)
+		for _, policy := range policies {
+			policyIds = append(policyIds, policy.ID)
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous policy name", "Several policies are named "+state.Name.ValueString()+" : "+strings.Join(policyIds, ", ")+". Use the ID of the policy instead.")
+		return
+	}
+	policy := policies[0]
+
+	source, err := d.client.GetPolicySource(ctx, policy.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading policy source", "Could not read source of policy "+policy.ID+" : "+err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(policy.ID)
+	state.Source = optionalString(string(source))
+	state.TotalAccounts = types.Int64Value(int64(policy.TotalAccounts))
+	state.TotalCollections = types.Int64Value(int64(policy.TotalCollections))
+	state.TotalResources = types.Int64Value(int64(policy.TotalResources))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *policyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+	"sync"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// postureLoadThreshold is the number of targeted lookups after which the full posture is loaded. A targeted apply
+// only resolves a few assets and never downloads the whole account, larger runs load the posture once instead.
+const postureLoadThreshold = 10
+
+// postureCache is the posture shared by every resource of the provider. Assets are resolved with targeted
+// lookups until the full posture is loaded, lazily and at most once. The assets modified by a mutation are
+// looked up again the next time they are needed.
+type postureCache struct {
+	client *boostsecurity.Client
+
+	// mutex guards the fields below, it is never held while querying the API.
+	mutex sync.Mutex
+	// loaded is set once the full posture is loaded.
+	loaded bool
+	// misses counts the targeted lookups started before the posture is loaded.
+	misses int
+	// providers maps the provider names to their IDs.
+	providers map[string]string
+	// assets holds the resolved assets, by path.
+	assets map[string]*cachedAsset
+	// sequence counts the invalidations, invalidated holds the sequence of the last invalidation of each path.
+	// A lookup started before an invalidation is not cached.
+	sequence    uint64
+	invalidated map[string]uint64
+
+	// lookups merges the concurrent lookups of the same asset, and the loads of the posture, into a single query.
+	lookups singleflight.Group
+}
+
+// cachedAsset is an asset found in the posture, resource is nil for a collection.
+type cachedAsset struct {
+	provider   string
+	providerId string
+	collection boostsecurity.OrganizationModel
+	resource   *boostsecurity.ResourcesModel
+}
+
+func newPostureCache(client *boostsecurity.Client) *postureCache {
+	return &postureCache{
+		client:      client,
+		providers:   make(map[string]string),
+		assets:      make(map[string]*cachedAsset),
+		invalidated: make(map[string]uint64),
+	}
+}
+
+// find looks up an asset by name, in the posture once it is loaded or with targeted lookups otherwise.
+func (c *postureCache) find(ctx context.Context, asset *boostsecurity.AssetModel) (*cachedAsset, error) {
+	path := assetPath(asset)
+	c.mutex.Lock()
+	found, ok := c.assets[path]
+	load := false
+	if !ok && !c.loaded {
+		c.misses++
+		load = c.misses > postureLoadThreshold
+	}
+	c.mutex.Unlock()
+	if ok {
+		return found, nil
+	}
+
+	if load {
+		if err := c.load(ctx); err != nil {
+			return nil, err
+		}
+		c.mutex.Lock()
+		found, ok = c.assets[path]
+		c.mutex.Unlock()
+		if ok {
+			return found, nil
+		}
+	}
+
+	// Assets missing from the posture are looked up as well, they may have been invalidated since it was loaded.
+	c.mutex.Lock()
+	generation := c.generation(asset)
+	c.mutex.Unlock()
+	// The generation is part of the key, a lookup started before an invalidation is not joined.
+	result, err, _ := c.lookups.Do(fmt.Sprintf("asset:%s#%d", path, generation), func() (any, error) {
+		found, err := c.lookup(ctx, asset)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if c.generation(asset) == generation {
+			c.assets[path] = found
+		}
+		return found, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*cachedAsset), nil
+}
+
+// load loads the full posture, unless it is already loaded. The assets invalidated while it loads are left out.
+func (c *postureCache) load(ctx context.Context) error {
+	_, err, _ := c.lookups.Do("posture", func() (any, error) {
+		c.mutex.Lock()
+		loaded, sequence := c.loaded, c.sequence
+		c.mutex.Unlock()
+		if loaded {
+			return nil, nil
+		}
+
+		tflog.Debug(ctx, "Building cache")
+		posture, err := c.client.GetPosture(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error building cache %w", err)
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		for _, provider := range posture.Providers {
+			c.providers[provider.Name] = provider.ID
+			for _, collection := range provider.Organizations {
+				resources := collection.Resources
+				collection.Resources = nil
+				c.store(sequence, &cachedAsset{provider: provider.Name, providerId: provider.ID, collection: collection})
+				for i := range resources {
+					c.store(sequence, &cachedAsset{provider: provider.Name, providerId: provider.ID, collection: collection, resource: &resources[i]})
+				}
+			}
+		}
+		c.loaded = true
+		return nil, nil
+	})
+
+	return err
+}
+
+// store caches an asset of the posture loaded at sequence, unless it was invalidated since. It must be called with
+// the mutex held.
+func (c *postureCache) store(sequence uint64, found *cachedAsset) {
+	asset := &boostsecurity.AssetModel{
+		Provider:   types.StringValue(found.provider),
+		Collection: types.StringValue(found.collection.Name),
+		Resource:   types.StringNull(),
+	}
+	if found.resource != nil {
+		asset.Resource = types.StringValue(found.resource.Name)
+	}
+	if c.generation(asset) > sequence {
+		return
+	}
+
+	c.assets[assetPath(asset)] = found
+}
+
+// invalidate drops an asset modified by a mutation, the rest of the posture is kept. The resources of a collection
+// are dropped along with it, as they inherit its policy and scanners.
+func (c *postureCache) invalidate(asset *boostsecurity.AssetModel) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.sequence++
+	path := assetPath(asset)
+	delete(c.assets, path)
+	c.invalidated[path] = c.sequence
+	if asset.Resource.IsNull() {
+		for assetPath, found := range c.assets {
+			if found.resource != nil && found.provider == asset.Provider.ValueString() && found.collection.Name == asset.Collection.ValueString() {
+				delete(c.assets, assetPath)
+			}
+		}
+	}
+}
+
+// generation is the sequence of the last invalidation of the asset or, for a resource, of its collection. It must
+// be called with the mutex held.
+func (c *postureCache) generation(asset *boostsecurity.AssetModel) uint64 {
+	generation := c.invalidated[assetPath(asset)]
+	if !asset.Resource.IsNull() {
+		generation = max(generation, c.invalidated[assetPath(&boostsecurity.AssetModel{Provider: asset.Provider, Collection: asset.Collection, Resource: types.StringNull()})])
+	}
+
+	return generation
+}
+
+// lookup resolves an asset with targeted queries.
+func (c *postureCache) lookup(ctx context.Context, asset *boostsecurity.AssetModel) (*cachedAsset, error) {
+	if !asset.Resource.IsNull() {
+		parent, err := c.find(ctx, &boostsecurity.AssetModel{
+			Provider:   asset.Provider,
+			Collection: asset.Collection,
+			Resource:   types.StringNull(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rcs, err := c.client.FindResource(ctx, parent.providerId, parent.collection.ID, asset.Resource.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if rcs == nil {
+			return nil, errAssetNotFound
+		}
+		return &cachedAsset{provider: parent.provider, providerId: parent.providerId, collection: parent.collection, resource: rcs}, nil
+	}
+
+	providerId, err := c.providerId(ctx, asset.Provider.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := c.client.FindCollection(ctx, providerId, asset.Collection.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, errAssetNotFound
+	}
+
+	return &cachedAsset{provider: asset.Provider.ValueString(), providerId: providerId, collection: *collection}, nil
+}
+
+// providerId resolves the ID of a provider by name, provider IDs do not change and are cached for the whole run.
+func (c *postureCache) providerId(ctx context.Context, name string) (string, error) {
+	c.mutex.Lock()
+	providerId, ok := c.providers[name]
+	c.mutex.Unlock()
+	if ok {
+		return providerId, nil
+	}
+
+	result, err, _ := c.lookups.Do("provider:"+name, func() (any, error) {
+		provider, err := c.client.FindProvider(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if provider == nil {
+			return nil, errAssetNotFound
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		c.providers[provider.Name] = provider.ID
+		return provider.ID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result.(string), nil
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+	"testing"
+)
+
+// fakePosture serves the queries resolving assets from a single provider with a single collection.
+type fakePosture struct {
+	mutex     sync.Mutex
+	resources []string
+	policy    string
+	// searchMisses makes the search filter match nothing, as it does for names it tokenizes differently.
+	searchMisses bool
+	operations   map[string]int
+}
+
+func newFakePosture(t *testing.T, resources int) (*fakePosture, *boostsecurity.Client) {
+	fake := &fakePosture{policy: "policy-a", operations: make(map[string]int)}
+	for i := 0; i < resources; i++ {
+		fake.resources = append(fake.resources, fmt.Sprintf("repo-%d", i))
+	}
+	server := httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(server.Close)
+
+	return fake, boostsecurity.NewClient(server.URL, boostsecurity.StaticToken("token"), boostsecurity.WithRetries(0, 0, 0))
+}
+
+func (f *fakePosture) serve(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	search, _ := request.Variables["search"].(string)
+	searching := strings.HasPrefix(request.OperationName, "Search")
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.operations[request.OperationName]++
+
+	providers := `{"providers":{"pageInfo":{},"edges":[{"node":{"providerId":"p1","name":"github"}}]}}`
+	collection := `{"collectionId":"c1","name":"boost","policy":{"policyId":"` + f.policy + `","assignment":"DIRECT"},"scanners":[]}`
+	collections := `{"pageInfo":{},"edges":[{"node":` + collection + `}]}`
+	if searching && f.searchMisses {
+		collections = `{"pageInfo":{},"edges":[]}`
+	}
+	resources := make([]string, 0)
+	for _, name := range f.resources {
+		if !searching || (!f.searchMisses && strings.Contains(name, search)) {
+			resources = append(resources, `{"node":{"resourceId":"id-`+name+`","name":"`+name+`","policy":{"policyId":"`+f.policy+`","assignment":"INHERITED"},"scanners":[]}}`)
+		}
+	}
+	connection := `{"pageInfo":{},"edges":[` + strings.Join(resources, ",") + `]}`
+
+	var data string
+	switch request.OperationName {
+	case "Providers", "SecurityPosture":
+		data = `{"securityPosture":` + providers + `}`
+	case "SearchCollections", "ProviderCollections":
+		data = `{"provider":{"collections":` + collections + `}}`
+	case "SearchResources", "ProviderCollection":
+		data = `{"provider":{"collection":{"collectionId":"c1","resources":` + connection + `}}}`
+	default:
+		http.Error(w, "unexpected operation "+request.OperationName, http.StatusBadRequest)
+		return
+	}
+	_, _ = w.Write([]byte(`{"data":` + data + `}`))
+}
+
+func (f *fakePosture) count(operation string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.operations[operation]
+}
+
+func resourceAsset(name string) *boostsecurity.AssetModel {
+	return &boostsecurity.AssetModel{Provider: types.StringValue("github"), Collection: types.StringValue("boost"), Resource: types.StringValue(name)}
+}
+
+func TestPostureCacheTargetedLookups(t *testing.T) {
+	fake, client := newFakePosture(t, 20)
+	cache := newPostureCache(client)
+
+	for i := 0; i < 3; i++ {
+		found, err := cache.find(context.Background(), resourceAsset(fmt.Sprintf("repo-%d", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found.resource.ID != fmt.Sprintf("id-repo-%d", i) {
+			t.Errorf("got resource %s, want id-repo-%d", found.resource.ID, i)
+		}
+	}
+
+	if count := fake.count("SecurityPosture"); count != 0 {
+		t.Errorf("got %d posture loads, want none for a few assets", count)
+	}
+	if count := fake.count("SearchCollections"); count != 1 {
+		t.Errorf("got %d collection lookups, want the collection resolved once", count)
+	}
+	if count := fake.count("SearchResources"); count != 3 {
+		t.Errorf("got %d resource lookups, want 3", count)
+	}
+}
+
+func TestPostureCacheLoadsPostureOnce(t *testing.T) {
+	fake, client := newFakePosture(t, 50)
+	cache := newPostureCache(client)
+
+	var group sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		group.Add(1)
+		go func(name string) {
+			defer group.Done()
+			if _, err := cache.find(context.Background(), resourceAsset(name)); err != nil {
+				errs <- err
+			}
+		}(fmt.Sprintf("repo-%d", i))
+	}
+	group.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	if count := fake.count("SecurityPosture"); count != 1 {
+		t.Errorf("got %d posture loads, want 1", count)
+	}
+	if count := fake.count("SearchResources"); count > postureLoadThreshold {
+		t.Errorf("got %d resource lookups, want at most %d before the posture is loaded", count, postureLoadThreshold)
+	}
+
+	lookups := fake.count("SearchResources")
+	if _, err := cache.find(context.Background(), resourceAsset("repo-42")); err != nil {
+		t.Fatal(err)
+	}
+	if count := fake.count("SearchResources"); count != lookups {
+		t.Errorf("got %d resource lookups, want no lookup once the posture is loaded", count-lookups)
+	}
+}
+
+func TestPostureCacheInvalidate(t *testing.T) {
+	fake, client := newFakePosture(t, 20)
+	cache := newPostureCache(client)
+	ctx := context.Background()
+
+	for i := 0; i < 20; i++ {
+		if _, err := cache.find(ctx, resourceAsset(fmt.Sprintf("repo-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count := fake.count("SecurityPosture"); count != 1 {
+		t.Fatalf("got %d posture loads, want 1", count)
+	}
+
+	fake.mutex.Lock()
+	fake.policy = "policy-b"
+	fake.mutex.Unlock()
+
+	// Invalidating the collection drops its resources, which are looked up again rather than reloading the posture.
+	cache.invalidate(&boostsecurity.AssetModel{Provider: types.StringValue("github"), Collection: types.StringValue("boost"), Resource: types.StringNull()})
+	found, err := cache.find(ctx, resourceAsset("repo-3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.resource.Policy != "policy-b" || found.collection.Policy != "policy-b" {
+		t.Errorf("got policies %s and %s, want the refreshed policy-b", found.resource.Policy, found.collection.Policy)
+	}
+	if count := fake.count("SecurityPosture"); count != 1 {
+		t.Errorf("got %d posture loads, want the posture loaded once", count)
+	}
+
+	// The resources dropped with their collection are looked up again once, then kept.
+	cache.invalidate(resourceAsset("repo-3"))
+	lookups := fake.count("SearchResources")
+	if _, err := cache.find(ctx, resourceAsset("repo-3")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.find(ctx, resourceAsset("repo-4")); err != nil {
+		t.Fatal(err)
+	}
+	if count := fake.count("SearchResources"); count != lookups+2 {
+		t.Errorf("got %d resource lookups, want 2", count-lookups)
+	}
+	if _, err := cache.find(ctx, resourceAsset("repo-4")); err != nil {
+		t.Fatal(err)
+	}
+	if count := fake.count("SearchResources"); count != lookups+2 {
+		t.Errorf("got %d resource lookups, want a resource looked up again to be kept", count-lookups)
+	}
+}
+
+func TestPostureCacheNotFound(t *testing.T) {
+	_, client := newFakePosture(t, 3)
+	cache := newPostureCache(client)
+
+	if _, err := cache.find(context.Background(), resourceAsset("missing")); err != errAssetNotFound {
+		t.Errorf("got error %v, want %v", err, errAssetNotFound)
+	}
+}
+
+func TestPostureCacheSearchMiss(t *testing.T) {
+	fake, client := newFakePosture(t, 3)
+	fake.searchMisses = true
+	cache := newPostureCache(client)
+
+	found, err := cache.find(context.Background(), resourceAsset("repo-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.collection.ID != "c1" || found.resource.ID != "id-repo-1" {
+		t.Errorf("got collection %s and resource %s, want c1 and id-repo-1", found.collection.ID, found.resource.ID)
+	}
+	if fake.count("ProviderCollections") != 1 || fake.count("ProviderCollection") != 1 {
+		t.Errorf("got %v, want the collections and resources listed once the search missed", fake.operations)
+	}
+}
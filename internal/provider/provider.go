@@ -2,10 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"os"
+	"sort"
+	"strings"
 	"terraform-provider-boostsecurity/internal/boostsecurity"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -30,8 +37,36 @@ func New(version string) func() provider.Provider {
 
 // boostsecurityProviderModel maps provider schema data to a Go type.
 type boostsecurityProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host               types.String  `tfsdk:"host"`
+	Environment        types.String  `tfsdk:"environment"`
+	Token              types.String  `tfsdk:"token"`
+	TokenFile          types.String  `tfsdk:"token_file"`
+	TokenCommand       types.List    `tfsdk:"token_command"`
+	FailOnMissingAsset types.Bool    `tfsdk:"fail_on_missing_asset"`
+	PageSize           types.Int64   `tfsdk:"page_size"`
+	Parallelism        types.Int64   `tfsdk:"parallelism"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin       types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String  `tfsdk:"retry_wait_max"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	Burst              types.Int64   `tfsdk:"burst"`
+	RequestTimeout     types.String  `tfsdk:"request_timeout"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String  `tfsdk:"client_cert"`
+	ClientKey          types.String  `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	SkipAPICheck       types.Bool    `tfsdk:"skip_api_check"`
+}
+
+// providerData is made available to the resources and data sources in their Configure.
+type providerData struct {
+	client *boostsecurity.Client
+	// cache is the posture shared by the resources, loaded on demand once enough assets are looked up.
+	cache *postureCache
+	// failOnMissingAsset makes Read fail instead of removing assets that no longer exist from the state.
+	failOnMissingAsset bool
 }
 
 // boostsecurityProvider is the provider implementation.
@@ -55,12 +90,100 @@ func (p *boostsecurityProvider) Schema(_ context.Context, _ provider.SchemaReque
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Description: "URI for Boost API.",
+				MarkdownDescription: "URI for Boost API. May also be provided via the BOOST_HOST environment variable. \n " +
+					"Overrides `environment`, it must be an absolute `https` URL to the GraphQL endpoint, such as `https://api.dev.boostsec.io/asset-management/graphql`.",
+				Optional: true,
+			},
+			"environment": schema.StringAttribute{
+				Description: fmt.Sprintf("Boost environment to connect to, one of %s. May also be provided via the BOOST_ENVIRONMENT environment variable. Required unless `host` is set.", environmentNames()),
 				Optional:    true,
 			},
 			"token": schema.StringAttribute{
 				Description: "API token for Boost API.",
 				Optional:    true,
 			},
+			"token_file": schema.StringAttribute{
+				Description: "Path to a file containing the API token for Boost API. May also be provided via the BOOST_TOKEN_FILE environment variable. Conflicts with `token` and `token_command`.",
+				MarkdownDescription: "Path to a file containing the API token for Boost API. May also be provided via the BOOST_TOKEN_FILE environment variable. Conflicts with `token` and `token_command`. \n " +
+					"The file is read again when the API rejects the token, so that it can be rotated.",
+				Optional: true,
+			},
+			"token_command": schema.ListAttribute{
+				Description: "Command printing the API token for Boost API on its standard output, such as a secrets manager CLI. Conflicts with `token` and `token_file`.",
+				MarkdownDescription: "Command printing the API token for Boost API on its standard output, such as a secrets manager CLI. Conflicts with `token` and `token_file`. \n " +
+					"The first element is the executable, the others its arguments. The token is cached and the command runs again when the API rejects it.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"fail_on_missing_asset": schema.BoolAttribute{
+				Description:         "Fail when an asset no longer exists instead of removing it from the state.",
+				MarkdownDescription: "Fail when an asset no longer exists instead of removing it from the state. \n Defaults to `false`, a warning is reported and terraform proposes to recreate the asset.",
+				Optional:            true,
+			},
+			"page_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of items requested per page when listing collections and resources. Defaults to %d.", boostsecurity.DefaultPageSize),
+				Optional:    true,
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of concurrent requests issued when loading collections and resources. Defaults to %d.", boostsecurity.DefaultParallelism),
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a request failing with a transient error is retried. Defaults to %d.", boostsecurity.DefaultMaxRetries),
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a request failing with a transient error is retried. Defaults to %d. \n ", boostsecurity.DefaultMaxRetries) +
+					"Queries are retried on network errors, throttling and server errors, mutations only when the request could not be sent. Set to `0` to disable retries.",
+				Optional: true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: fmt.Sprintf("Minimum time to wait before retrying a request, as a duration such as `500ms`. Defaults to `%s`.", boostsecurity.DefaultRetryWaitMin),
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: fmt.Sprintf("Maximum time to wait before retrying a request, as a duration such as `1m`. Defaults to `%s`.", boostsecurity.DefaultRetryWaitMax),
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait before retrying a request, as a duration such as `1m`. Defaults to `%s`. \n ", boostsecurity.DefaultRetryWaitMax) +
+					"A `Retry-After` header sent by the API takes precedence over the backoff, up to this maximum.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to the Boost API, shared by every resource. Requests are not limited when unset.",
+				Optional:    true,
+			},
+			"burst": schema.Int64Attribute{
+				Description: "Number of requests that can be sent at once before `requests_per_second` applies. Defaults to `requests_per_second` rounded up.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("Time allowed for each request to the Boost API, as a duration such as `2m`. May also be provided via the BOOST_REQUEST_TIMEOUT environment variable. Defaults to `%ds`.", int(boostsecurity.DefaultRequestTimeout.Seconds())),
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the Boost API. May also be provided via the BOOST_PROXY_URL environment variable. Defaults to the HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded certificate authority to trust in addition to the system ones. May also be provided via the BOOST_CA_CERT_FILE environment variable. Conflicts with `ca_cert_pem`.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded certificate authority to trust in addition to the system ones. May also be provided via the BOOST_CA_CERT_PEM environment variable. Conflicts with `ca_cert_file`.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "Path to a PEM-encoded client certificate for mutual TLS. May also be provided via the BOOST_CLIENT_CERT environment variable. Requires `client_key`.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "Path to the PEM-encoded private key of the client certificate. May also be provided via the BOOST_CLIENT_KEY environment variable. Requires `client_cert`.",
+				Optional:    true,
+			},
+			"skip_api_check": schema.BoolAttribute{
+				Description: "Skip checking that the Boost API provides the fields and types this version of the provider relies on. Defaults to `false`.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the Boost API certificate. Only meant for testing. May also be provided via the BOOST_INSECURE_SKIP_VERIFY environment variable. Defaults to `false`.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -88,6 +211,15 @@ func (p *boostsecurityProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	if config.Environment.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Unknown Boost Environment",
+			"The provider cannot create the Boost API client as there is an unknown configuration value for the Boost environment. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the BOOST_ENVIRONMENT environment variable.",
+		)
+	}
+
 	if config.Token.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
@@ -97,6 +229,24 @@ func (p *boostsecurityProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	if config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Unknown Boost API Token File",
+			"The provider cannot create the Boost API client as there is an unknown configuration value for the Boost API token file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the BOOST_TOKEN_FILE environment variable.",
+		)
+	}
+
+	if config.TokenCommand.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_command"),
+			"Unknown Boost API Token Command",
+			"The provider cannot create the Boost API client as there is an unknown configuration value for the Boost API token command. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -105,67 +255,236 @@ func (p *boostsecurityProvider) Configure(ctx context.Context, req provider.Conf
 	// with Terraform configuration value if set.
 
 	host := os.Getenv("BOOST_HOST")
-	token := os.Getenv("BOOST_TOKEN")
+	environment := os.Getenv("BOOST_ENVIRONMENT")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
-	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
+	if !config.Environment.IsNull() {
+		environment = config.Environment.ValueString()
+	}
+
+	// The host overrides the environment, there is no default so that the token is only sent to an
+	// explicitly configured endpoint.
+	if environment != "" {
+		if endpoint, ok := boostsecurity.Environments[environment]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Invalid Boost Environment",
+				fmt.Sprintf("The Boost environment must be one of %s, got: %q.", environmentNames(), environment),
+			)
+		} else if host == "" && config.Host.IsNull() {
+			host = endpoint
+		}
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	if host == "" && !resp.Diagnostics.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing Boost API Host",
 			"The provider cannot create the Boost API client as there is a missing or empty value for the Boost API host. "+
-				"Set the host value in the configuration or use the BOOST_HOST environment variable. "+
+				"Set the host or environment value in the configuration or use the BOOST_HOST or BOOST_ENVIRONMENT environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
+	} else if err := boostsecurity.ValidateEndpoint(host); host != "" && err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid Boost API Host",
+			fmt.Sprintf("The Boost API host must be an absolute https URL to the GraphQL endpoint, such as %q, got: %q : %s.", boostsecurity.Environments["dev"], host, err.Error()),
+		)
 	}
 
-	if token == "" {
+	token := tokenSource(ctx, &config, &resp.Diagnostics)
+
+	if !config.PageSize.IsNull() && config.PageSize.ValueInt64() < 1 {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Missing Boost API Token",
-			"The provider cannot create the Boost API client as there is a missing or empty value for the Boost API token. "+
-				"Set the username value in the configuration or use the BOOST_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("page_size"),
+			"Invalid Boost API Page Size",
+			fmt.Sprintf("The page size must be at least 1, got: %d.", config.PageSize.ValueInt64()),
+		)
+	}
+
+	if !config.Parallelism.IsNull() && config.Parallelism.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parallelism"),
+			"Invalid Boost API Parallelism",
+			fmt.Sprintf("The parallelism must be at least 1, got: %d.", config.Parallelism.ValueInt64()),
 		)
 	}
 
+	if !config.MaxRetries.IsNull() && config.MaxRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Boost API Max Retries",
+			fmt.Sprintf("The max retries must be at least 0, got: %d.", config.MaxRetries.ValueInt64()),
+		)
+	}
+
+	retryWaitMin := parseDuration(config.RetryWaitMin, path.Root("retry_wait_min"), boostsecurity.DefaultRetryWaitMin, &resp.Diagnostics)
+	retryWaitMax := parseDuration(config.RetryWaitMax, path.Root("retry_wait_max"), boostsecurity.DefaultRetryWaitMax, &resp.Diagnostics)
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Boost API Retry Wait",
+			fmt.Sprintf("The minimum retry wait %s is greater than the maximum retry wait %s.", retryWaitMin, retryWaitMax),
+		)
+	}
+
+	if !config.RequestsPerSecond.IsNull() && config.RequestsPerSecond.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Boost API Requests Per Second",
+			fmt.Sprintf("The requests per second must be greater than 0, got: %g.", config.RequestsPerSecond.ValueFloat64()),
+		)
+	}
+
+	if !config.Burst.IsNull() {
+		if config.RequestsPerSecond.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("burst"),
+				"Invalid Boost API Burst",
+				"The burst only applies when requests_per_second is set.",
+			)
+		} else if config.Burst.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("burst"),
+				"Invalid Boost API Burst",
+				fmt.Sprintf("The burst must be at least 1, got: %d.", config.Burst.ValueInt64()),
+			)
+		}
+	}
+
+	transport := transportConfig(&config, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	httpClient, err := boostsecurity.NewHTTPClient(transport)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Boost API HTTP Client",
+			"An unexpected error occurred when configuring the HTTP transport of the Boost API client : "+err.Error(),
+		)
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "boost_host", host)
-	ctx = tflog.SetField(ctx, "boost_token", token)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "boost_token")
+	if !config.Token.IsNull() {
+		ctx = tflog.SetField(ctx, "boost_token", config.Token.ValueString())
+		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "boost_token")
+	}
 
 	tflog.Debug(ctx, "Creating GQL client")
 
+	opts := []boostsecurity.Option{boostsecurity.WithHTTPClient(httpClient)}
+	if !config.PageSize.IsNull() {
+		opts = append(opts, boostsecurity.WithPageSize(int(config.PageSize.ValueInt64())))
+	}
+	if !config.Parallelism.IsNull() {
+		opts = append(opts, boostsecurity.WithParallelism(int(config.Parallelism.ValueInt64())))
+	}
+	maxRetries := boostsecurity.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	opts = append(opts, boostsecurity.WithRetries(maxRetries, retryWaitMin, retryWaitMax))
+	if !config.RequestsPerSecond.IsNull() {
+		burst := int(math.Ceil(config.RequestsPerSecond.ValueFloat64()))
+		if !config.Burst.IsNull() {
+			burst = int(config.Burst.ValueInt64())
+		}
+		opts = append(opts, boostsecurity.WithRateLimit(config.RequestsPerSecond.ValueFloat64(), burst))
+	}
+
 	// Create a new HashiCups client using the configuration values
-	client := boostsecurity.NewClient(host, token)
+	client := boostsecurity.NewClient(host, token, opts...)
+
+	if !config.SkipAPICheck.ValueBool() {
+		err = client.CheckCompatibility(ctx)
+		var compatibilityErr *boostsecurity.CompatibilityError
+		if errors.As(err, &compatibilityErr) {
+			resp.Diagnostics.AddError(
+				"Incompatible Boost API",
+				fmt.Sprintf("The Boost API at %s does not provide what this version of the provider relies on, upgrade the provider or set skip_api_check to bypass this check. Missing:\n - %s",
+					host, strings.Join(compatibilityErr.Missing, "\n - ")),
+			)
+			return
+		}
+		if err != nil {
+			// Only a reported schema mismatch fails, the API may be reachable by the time it is used.
+			resp.Diagnostics.AddWarning(
+				"Unable to Check Boost API",
+				"Could not check the compatibility of the Boost API at "+host+", the check is skipped : "+err.Error(),
+			)
+		}
+	}
 
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
-	resp.ResourceData = client
+	data := &providerData{
+		client:             client,
+		cache:              newPostureCache(client),
+		failOnMissingAsset: config.FailOnMissingAsset.ValueBool(),
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Boost client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *boostsecurityProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewProvidersDataSource,
+		NewCollectionsDataSource,
+		NewResourcesDataSource,
+		NewProvisionPlanDataSource,
+		NewPolicyDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *boostsecurityProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewScannerCoverageResource,
+		NewScannerConfigResource,
+		NewScannerRulesetResource,
+		NewAccountAutoAssignmentResource,
+		NewAnalyzerAutoAssignmentResource,
 	}
 }
+
+// parseDuration parses an optional duration attribute, returning defaultValue when it is not set.
+func parseDuration(value types.String, attributePath path.Path, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Boost API Duration",
+			fmt.Sprintf("Expected a positive duration such as `30s`, got: %q.", value.ValueString()),
+		)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// environmentNames lists the Boost environments, for diagnostics and descriptions.
+func environmentNames() string {
+	names := make([]string, 0)
+	for name := range boostsecurity.Environments {
+		names = append(names, "`"+name+"`")
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
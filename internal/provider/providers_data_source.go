@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providersDataSource{}
+	_ datasource.DataSourceWithConfigure = &providersDataSource{}
+)
+
+// NewProvidersDataSource is a helper function to simplify the provider implementation.
+func NewProvidersDataSource() datasource.DataSource {
+	return &providersDataSource{}
+}
+
+// providersDataSource is the data source implementation.
+type providersDataSource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the data source type name.
+func (d *providersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_providers"
+}
+
+// Schema defines the schema for the data source.
+func (d *providersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the providers connected to the account.",
+		MarkdownDescription: "Lists the providers connected to the account. \n " +
+			"Use it to iterate over the actual integrations rather than hard-coding provider names.",
+		Attributes: map[string]schema.Attribute{
+			"providers": schema.ListNestedAttribute{
+				Description: "List of connected providers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"provider_id": schema.StringAttribute{
+							Description: "The ID of the provider.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the provider, as used by the `provider` of the fortify resource.",
+							Computed:    true,
+						},
+						"total_need_attention": schema.Int64Attribute{
+							Description: "The number of resources of the provider that need attention.",
+							Computed:    true,
+						},
+						"total_resources": schema.Int64Attribute{
+							Description: "The number of resources of the provider.",
+							Computed:    true,
+						},
+						"total_collections": schema.Int64Attribute{
+							Description: "The number of collections of the provider.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	providers, err := d.client.GetProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading providers", "Could not read providers : "+err.Error())
+		return
+	}
+
+	state := boostsecurity.ProvidersDataSourceState{
+		Providers: make([]boostsecurity.ProviderDataModel, 0),
+	}
+	for _, provider := range providers {
+		state.Providers = append(state.Providers, boostsecurity.ProviderDataModel{
+			ProviderID:         types.StringValue(provider.ID),
+			Name:               types.StringValue(provider.Name),
+			TotalNeedAttention: types.Int64Value(int64(provider.TotalNeedAttention)),
+			TotalResources:     types.Int64Value(int64(provider.TotalResources)),
+			TotalCollections:   types.Int64Value(int64(provider.TotalCollections)),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
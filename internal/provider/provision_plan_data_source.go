@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &provisionPlanDataSource{}
+	_ datasource.DataSourceWithConfigure = &provisionPlanDataSource{}
+)
+
+// NewProvisionPlanDataSource is a helper function to simplify the provider implementation.
+func NewProvisionPlanDataSource() datasource.DataSource {
+	return &provisionPlanDataSource{}
+}
+
+// provisionPlanDataSource is the data source implementation.
+type provisionPlanDataSource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the data source type name.
+func (d *provisionPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provision_plan"
+}
+
+// Schema defines the schema for the data source.
+func (d *provisionPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the scanners that can be provisioned on a selection of assets.",
+		MarkdownDescription: "Lists the scanners that can be provisioned on a selection of assets. \n " +
+			"Every scanner is returned with its availability, so that unavailable scanners can be explained rather than silently ignored.",
+		Attributes: map[string]schema.Attribute{
+			"asset_type": schema.StringAttribute{
+				Description: "The type of the selected assets, either `COLLECTION` or `RESOURCE`.",
+				Required:    true,
+			},
+			"asset_ids": schema.SetAttribute{
+				Description: "The IDs of the selected assets.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"total_selected_collections": schema.Int64Attribute{
+				Description: "The number of collections in the selection.",
+				Computed:    true,
+			},
+			"total_selected_resources": schema.Int64Attribute{
+				Description: "The number of resources in the selection.",
+				Computed:    true,
+			},
+			"scanners": schema.ListNestedAttribute{
+				Description: "List of the scanners of the plan.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scanner_id": schema.StringAttribute{
+							Description: "The ID of the scanner, as used by the `scanners` of the fortify resource.",
+							Computed:    true,
+						},
+						"scanner_name": schema.StringAttribute{
+							Description: "The name of the scanner.",
+							Computed:    true,
+						},
+						"categories": schema.ListAttribute{
+							Description: "The security categories covered by the scanner.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"availability": schema.StringAttribute{
+							Description:         "The availability of the scanner for the selection.",
+							MarkdownDescription: "The availability of the scanner for the selection. \n Only `AVAILABLE` scanners can be provisioned, the other values tell what is missing, such as `MISSING_SCM_INSTALLATION`.",
+							Computed:            true,
+						},
+						"targets": schema.ListAttribute{
+							Description: "The flows the scanner runs on, `MAIN_FLOW` or `PR_FLOW`.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"total_applicable_collections": schema.Int64Attribute{
+							Description: "The number of selected collections the scanner applies to.",
+							Computed:    true,
+						},
+						"total_applicable_resources": schema.Int64Attribute{
+							Description: "The number of selected resources the scanner applies to.",
+							Computed:    true,
+						},
+						"ruleset_required": schema.BoolAttribute{
+							Description: "Whether a ruleset is required to provision the scanner.",
+							Computed:    true,
+						},
+						"rulesets": schema.ListAttribute{
+							Description: "The names of the rulesets offered for the scanner.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *provisionPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state boostsecurity.ProvisionPlanDataSourceState
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetType := boostsecurity.AssetType(state.AssetType.ValueString())
+	if assetType != boostsecurity.AssetTypeCollection && assetType != boostsecurity.AssetTypeResource {
+		resp.Diagnostics.AddAttributeError(path.Root("asset_type"), "Invalid asset type", "Asset type must be COLLECTION or RESOURCE, got : "+string(assetType))
+		return
+	}
+
+	var assetIds []string
+	assetIds, diags = toStringArray(ctx, state.AssetIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(assetIds) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("asset_ids"), "Missing asset IDs", "At least one asset must be selected.")
+		return
+	}
+
+	plan, err := d.client.GetAssetsProvisionPlan(ctx, assetType, assetIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting plan for assets", "Could not get plan : "+err.Error())
+		return
+	}
+
+	state.TotalSelectedCollections = types.Int64Value(int64(plan.TotalSelectedCollections))
+	state.TotalSelectedResources = types.Int64Value(int64(plan.TotalSelectedResources))
+	state.Scanners = make([]boostsecurity.ProvisionPlanScannerState, 0)
+	for _, scanner := range plan.Scanners {
+		categories := make([]attr.Value, 0)
+		for _, category := range scanner.Categories {
+			categories = append(categories, types.StringValue(string(category)))
+		}
+		targets := make([]attr.Value, 0)
+		for _, target := range scanner.Targets {
+			targets = append(targets, types.StringValue(string(target)))
+		}
+		rulesets := make([]attr.Value, 0)
+		for _, ruleset := range scanner.Rulesets {
+			rulesets = append(rulesets, types.StringValue(ruleset))
+		}
+
+		state.Scanners = append(state.Scanners, boostsecurity.ProvisionPlanScannerState{
+			ScannerID:                  types.StringValue(scanner.ID),
+			ScannerName:                types.StringValue(scanner.Name),
+			Categories:                 types.ListValueMust(types.StringType, categories),
+			Availability:               types.StringValue(string(scanner.Availability)),
+			Targets:                    types.ListValueMust(types.StringType, targets),
+			TotalApplicableCollections: types.Int64Value(int64(scanner.TotalApplicableCollections)),
+			TotalApplicableResources:   types.Int64Value(int64(scanner.TotalApplicableResources)),
+			RulesetRequired:            types.BoolValue(scanner.RulesetRequired),
+			Rulesets:                   types.ListValueMust(types.StringType, rulesets),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *provisionPlanDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
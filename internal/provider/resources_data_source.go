@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourcesDataSource{}
+)
+
+// NewResourcesDataSource is a helper function to simplify the provider implementation.
+func NewResourcesDataSource() datasource.DataSource {
+	return &resourcesDataSource{}
+}
+
+// resourcesDataSource is the data source implementation.
+type resourcesDataSource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the data source type name.
+func (d *resourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+// Schema defines the schema for the data source.
+func (d *resourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the resources of a provider matching filters.",
+		MarkdownDescription: "Lists the resources of a provider matching filters, such as the repositories of an organization missing a coverage. \n " +
+			"The filters take the values offered by the filters of the Boost security posture, every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"provider_name": schema.StringAttribute{
+				Description: "The name of the provider of the resources.",
+				Required:    true,
+			},
+			"filters": schema.SingleNestedAttribute{
+				Description: "The filters the resources must match.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"collections": schema.SetAttribute{
+						Description: "The collections the resources must belong to.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"missing_coverages": schema.SetAttribute{
+						Description: "The security categories the resources must be missing, such as `SCA`.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"resource_attributes": schema.SetAttribute{
+						Description: "The attributes the resources must have.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"policies": schema.SetAttribute{
+						Description: "The IDs of the policies the resources must be assigned.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"provisioned_analyzers": schema.SetAttribute{
+						Description: "The analyzers that must be provisioned on the resources.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"search": schema.StringAttribute{
+						Description: "A search term the resources must match.",
+						Optional:    true,
+					},
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "List of the matching resources.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							Description: "The ID of the resource.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the resource, as used by the `resource` of the fortify resource.",
+							Computed:    true,
+						},
+						"collection_id": schema.StringAttribute{
+							Description: "The ID of the collection of the resource.",
+							Computed:    true,
+						},
+						"collection": schema.StringAttribute{
+							Description: "The name of the collection of the resource, as used by the `collection` of the fortify resource.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "The ID of the policy of the resource.",
+							Computed:    true,
+						},
+						"scanners": schema.SetNestedAttribute{
+							Description: "Set of scanners provisioned on the resource.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the scanner.",
+										Computed:    true,
+									},
+									"ruleset": schema.StringAttribute{
+										Description: "The name of the ruleset of the scanner.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state boostsecurity.ResourcesDataSourceState
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := toFilters(ctx, state.Filters, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, err := d.client.FindProvider(ctx, state.ProviderName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding provider", "Could not find provider : "+err.Error())
+		return
+	}
+	if provider == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("provider_name"), "Provider not found", "Could not find provider : "+state.ProviderName.ValueString())
+		return
+	}
+
+	collections, err := d.client.ListResources(ctx, provider.ID, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resources", "Could not read resources of provider "+provider.Name+" : "+err.Error())
+		return
+	}
+
+	state.Resources = make([]boostsecurity.ResourceDataModel, 0)
+	for _, collection := range collections {
+		for _, resource := range collection.Resources {
+			scanners, _ := toScannerValues(resource.Scanners)
+			state.Resources = append(state.Resources, boostsecurity.ResourceDataModel{
+				ResourceID:   types.StringValue(resource.ID),
+				Name:         types.StringValue(resource.Name),
+				CollectionID: types.StringValue(collection.ID),
+				Collection:   types.StringValue(collection.Name),
+				Policy:       optionalString(resource.Policy),
+				Scanners:     scanners,
+			})
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+// toFilters converts the configured filters to the API input. Filters that are not set are sent as null, or left
+// out for the search, so that the API ignores them.
+func toFilters(ctx context.Context, in *boostsecurity.FiltersModel, diags *diag.Diagnostics) boostsecurity.Filters {
+	if in == nil {
+		return boostsecurity.Filters{}
+	}
+
+	filterValues := func(values types.Set) []string {
+		if len(values.Elements()) == 0 {
+			return nil
+		}
+		converted, elementDiags := toStringArray(ctx, values)
+		diags.Append(elementDiags...)
+		return converted
+	}
+
+	return boostsecurity.Filters{
+		Collections:          filterValues(in.Collections),
+		MissingCoverages:     filterValues(in.MissingCoverages),
+		ResourceAttributes:   filterValues(in.ResourceAttributes),
+		Policy:               filterValues(in.Policies),
+		ProvisionedAnalyzers: filterValues(in.ProvisionedAnalyzers),
+		Search:               in.Search.ValueString(),
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+	"testing"
+)
+
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, 0)
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestToFilters(t *testing.T) {
+	unset := &boostsecurity.FiltersModel{
+		Collections:          types.SetNull(types.StringType),
+		MissingCoverages:     types.SetNull(types.StringType),
+		ResourceAttributes:   types.SetNull(types.StringType),
+		Policies:             types.SetNull(types.StringType),
+		ProvisionedAnalyzers: types.SetNull(types.StringType),
+		Search:               types.StringNull(),
+	}
+	empty := &boostsecurity.FiltersModel{
+		Collections:          stringSet(),
+		MissingCoverages:     stringSet(),
+		ResourceAttributes:   stringSet(),
+		Policies:             stringSet(),
+		ProvisionedAnalyzers: stringSet(),
+		Search:               types.StringValue(""),
+	}
+	set := &boostsecurity.FiltersModel{
+		Collections:          stringSet("boost"),
+		MissingCoverages:     stringSet("SCA"),
+		ResourceAttributes:   stringSet("archived"),
+		Policies:             stringSet("policy-id"),
+		ProvisionedAnalyzers: stringSet("analyzer"),
+		Search:               types.StringValue("api"),
+	}
+
+	nulls := `{"collectionProvisioningStatuses":null,"resourceProvisioningStatuses":null,"collections":null,"missingCoverages":null,"resourceAttributes":null,"policyType":null,"policy":null,"provisionedAnalyzers":null}`
+	tests := []struct {
+		name string
+		in   *boostsecurity.FiltersModel
+		want string
+	}{
+		{name: "no filters", in: nil, want: nulls},
+		{name: "unset filters", in: unset, want: nulls},
+		{name: "empty filters", in: empty, want: nulls},
+		{
+			name: "set filters",
+			in:   set,
+			want: `{"collectionProvisioningStatuses":null,"resourceProvisioningStatuses":null,"collections":["boost"],"missingCoverages":["SCA"],"resourceAttributes":["archived"],"policyType":null,"policy":["policy-id"],"provisionedAnalyzers":["analyzer"],"search":"api"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			filters := toFilters(context.Background(), test.in, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			got, err := json.Marshal(filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &scannerConfigResource{}
+	_ resource.ResourceWithConfigure   = &scannerConfigResource{}
+	_ resource.ResourceWithImportState = &scannerConfigResource{}
+)
+
+// NewScannerConfigResource is a helper function to simplify the provider implementation.
+func NewScannerConfigResource() resource.Resource {
+	return &scannerConfigResource{}
+}
+
+// scannerConfigResource is the resource implementation.
+type scannerConfigResource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the resource type name.
+func (r *scannerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scanner_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *scannerConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the global configuration of a scanner.",
+		MarkdownDescription: "Manages the global configuration of a scanner. \n " +
+			"Scanners reporting a `MISSING_CONFIG` availability require this configuration before they can be provisioned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the scanner config, same as the analyzer ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"analyzer_id": schema.StringAttribute{
+				Description: "The ID of the analyzer to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"global_configs": schema.MapAttribute{
+				Description: "The global configuration of the analyzer, as key/value mappings.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Whether the scanner config is in use.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *scannerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATING")
+	var state boostsecurity.ScannerConfigState
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *scannerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READING")
+	var state boostsecurity.ScannerConfigState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetScannerConfig(ctx, state.AnalyzerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scanner config", "Could not read scanner config : "+err.Error())
+		return
+	}
+	if config == nil {
+		tflog.Debug(ctx, "Scanner config not found, removing from state", map[string]any{"analyzer_id": state.AnalyzerID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = setScannerConfigState(&state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *scannerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state boostsecurity.ScannerConfigState
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *scannerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state boostsecurity.ScannerConfigState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rulesets are not owned by this resource, only the global configs are cleared when some remain.
+	analyzerId := state.AnalyzerID.ValueString()
+	err := r.client.ClearScannerGlobalConfigs(ctx, analyzerId)
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error deleting scanner config", "Could not delete scanner config "+analyzerId, err)
+		return
+	}
+}
+
+func (r *scannerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("analyzer_id"), req.ID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scannerConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *scannerConfigResource) apply(ctx context.Context, state *boostsecurity.ScannerConfigState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	globalConfigs := make(map[string]string)
+	diags = state.GlobalConfigs.ElementsAs(ctx, &globalConfigs, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	config, err := r.client.UpdateScannerConfig(ctx, state.AnalyzerID.ValueString(), func(config *boostsecurity.ScannerConfigModel) error {
+		config.GlobalConfigs = globalConfigs
+		return nil
+	})
+	if err != nil {
+		addOperationError(&diags, "Error updating scanner config", "Could not update scanner config "+state.AnalyzerID.ValueString(), err)
+		return diags
+	}
+
+	return setScannerConfigState(state, config)
+}
+
+func setScannerConfigState(state *boostsecurity.ScannerConfigState, config *boostsecurity.ScannerConfigModel) diag.Diagnostics {
+	globalConfigs, diags := toMapValue(config.GlobalConfigs)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.ID = types.StringValue(config.ID)
+	state.AnalyzerID = types.StringValue(config.ID)
+	state.GlobalConfigs = globalConfigs
+	state.InUse = types.BoolValue(config.InUse)
+
+	return diags
+}
+
+func toMapValue(in map[string]string) (types.Map, diag.Diagnostics) {
+	elements := make(map[string]attr.Value)
+	for key, value := range in {
+		elements[key] = types.StringValue(value)
+	}
+
+	return types.MapValue(types.StringType, elements)
+}
@@ -14,16 +14,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"slices"
+	"strings"
 	"terraform-provider-boostsecurity/internal/boostsecurity"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &scannerCoverageResource{}
-	_ resource.ResourceWithConfigure  = &scannerCoverageResource{}
-	_ resource.ResourceWithModifyPlan = &scannerCoverageResource{}
+	_ resource.Resource                 = &scannerCoverageResource{}
+	_ resource.ResourceWithConfigure    = &scannerCoverageResource{}
+	_ resource.ResourceWithModifyPlan   = &scannerCoverageResource{}
+	_ resource.ResourceWithUpgradeState = &scannerCoverageResource{}
+	_ resource.ResourceWithImportState  = &scannerCoverageResource{}
 )
 
+// errAssetNotFound is returned when an asset is not in the cache, it is not managed by an integration anymore.
+var errAssetNotFound = errors.New("could not find asset. Make sure the asset is managed by an integration")
+
+var scannerAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"ruleset": types.StringType,
+}
+
 // NewScannerCoverageResource is a helper function to simplify the provider implementation.
 func NewScannerCoverageResource() resource.Resource {
 	return &scannerCoverageResource{}
@@ -31,8 +42,9 @@ func NewScannerCoverageResource() resource.Resource {
 
 // scannerCoverageResource is the resource implementation.
 type scannerCoverageResource struct {
-	client *boostsecurity.Client
-	cache  *boostsecurity.ProvidersModel
+	client             *boostsecurity.Client
+	cache              *postureCache
+	failOnMissingAsset bool
 }
 
 // Metadata returns the resource type name.
@@ -43,6 +55,7 @@ func (r *scannerCoverageResource) Metadata(_ context.Context, req resource.Metad
 // Schema defines the schema for the resource.
 func (r *scannerCoverageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     2,
 		Description: "Manages Scanner coverage.",
 		Attributes: map[string]schema.Attribute{
 			"asset": schema.SingleNestedAttribute{
@@ -69,10 +82,23 @@ func (r *scannerCoverageResource) Schema(_ context.Context, _ resource.SchemaReq
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
-					"scanners": schema.ListAttribute{
-						Description: "List of scanners for the asset.",
-						ElementType: types.StringType,
+					"scanners": schema.SetNestedAttribute{
+						Description: "Set of scanners for the asset.",
 						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Description: "The ID of the scanner.",
+									Required:    true,
+								},
+								"ruleset": schema.StringAttribute{
+									Description: "The name of the ruleset for the scanner.",
+									MarkdownDescription: "The name of the ruleset for the scanner. \n It must be one of the rulesets offered for the asset, and is required when the scanner requires a ruleset. " +
+										"The API does not accept a ruleset when provisioning a scanner, so when set it must also match the ruleset active on the asset as reported by `assigned_rulesets`. A ruleset changed outside of terraform shows as drift.",
+									Optional: true,
+								},
+							},
+						},
 					},
 					"policy": schema.StringAttribute{
 						Description:         "The policy for the asset.",
@@ -84,6 +110,11 @@ func (r *scannerCoverageResource) Schema(_ context.Context, _ resource.SchemaReq
 						MarkdownDescription: "The policy assigned to the asset. \n This might differ from the policy field as a resource might not be allow to change policy.",
 						Computed:            true,
 					},
+					"assigned_rulesets": schema.MapAttribute{
+						Description: "The rulesets active on the asset, by scanner ID.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 				},
 			},
 		},
@@ -104,7 +135,7 @@ func (r *scannerCoverageResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
-	assetId, err := r.getAssetId(&state.Asset)
+	assetId, err := r.getAssetId(ctx, &state.Asset)
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 		return
@@ -116,6 +147,12 @@ func (r *scannerCoverageResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	diags = r.validateRulesets(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.Plan.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -133,7 +170,7 @@ func (r *scannerCoverageResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	asset, err := r.findInCache(&state.Asset)
+	asset, err := r.findInCache(ctx, &state.Asset)
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 		return
@@ -145,17 +182,24 @@ func (r *scannerCoverageResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	diags = r.validateRulesets(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	policyId := ""
 	if !state.Asset.Policy.IsNull() {
 		policyId = state.Asset.Policy.ValueString()
 	}
 
-	var scannerIds []string
-	scannerIds, diags = toStringArray(ctx, state.Asset.Scanners)
+	var scanners []boostsecurity.ScannerModel
+	scanners, diags = toScanners(ctx, state.Asset.Scanners)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	scannerIds := toScannerIds(scanners)
 
 	if !state.Asset.Policy.IsNull() || len(state.Asset.Scanners.Elements()) > 0 {
 		assetType := boostsecurity.AssetTypeResource
@@ -164,15 +208,23 @@ func (r *scannerCoverageResource) Create(ctx context.Context, req resource.Creat
 
 		}
 		err = r.client.ApplyPlan(ctx, asset.ID.ValueString(), assetType, policyId, scannerIds, []string{})
+		r.cache.invalidate(&state.Asset)
+		if err != nil {
+			addOperationError(&resp.Diagnostics, "Error applying plan", "Could not apply plan on asset "+assetPath(&state.Asset), err)
+			return
+		}
+
+		// The asset is read again, the assigned policy and rulesets reflect the applied plan.
+		asset, err = r.findInCache(ctx, &state.Asset)
 		if err != nil {
-			tflog.Debug(ctx, spew.Sdump(err))
-			resp.Diagnostics.AddError("Error applying plan", "RIP : "+err.Error())
+			resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 			return
 		}
 	}
 
 	state.Asset.ID = asset.ID
 	state.Asset.AssignedPolicy = asset.Policy
+	state.Asset.AssignedRulesets = asset.AssignedRulesets
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -191,31 +243,50 @@ func (r *scannerCoverageResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	posture, err := r.client.GetPosture(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected error getting posture",
-			fmt.Sprintf("While building cache, got: %T.", err),
+	asset, err := r.findInCache(ctx, &state.Asset)
+	if errors.Is(err, errAssetNotFound) && !r.failOnMissingAsset {
+		resp.Diagnostics.AddWarning(
+			"Asset not found, removing from state",
+			"Asset "+assetPath(&state.Asset)+" no longer exists, it was either deleted or its integration was uninstalled. "+
+				"It is removed from the state. Set fail_on_missing_asset on the provider to fail instead.",
 		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
+		return
+	}
 
+	diags = r.validateScannerIds(ctx, state, asset.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	r.cache = posture
 
-	asset, err := r.findInCache(&state.Asset)
+	provisioned, policyAssignment, err := r.findCachedAsset(ctx, &state.Asset)
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 		return
 	}
 
-	diags = r.validateScannerIds(ctx, state, asset.ID.ValueString())
+	var priorScanners []boostsecurity.ScannerModel
+	priorScanners, diags = toScanners(ctx, state.Asset.Scanners)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	state.Asset.Scanners = refreshScanners(priorScanners, provisioned)
+	// Only a policy assigned directly on the asset can be managed, an inherited policy is reported by assigned_policy.
+	if policyAssignment == boostsecurity.PolicyAssignmentDirect {
+		state.Asset.Policy = asset.Policy
+	} else {
+		state.Asset.Policy = types.StringNull()
+	}
 	state.Asset.ID = asset.ID
 	state.Asset.AssignedPolicy = asset.Policy
+	state.Asset.AssignedRulesets = asset.AssignedRulesets
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -239,29 +310,34 @@ func (r *scannerCoverageResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	var previousSannerIds []string
-	previousSannerIds, diags = toStringArray(ctx, oldState.Asset.Scanners)
+	var previousScanners []boostsecurity.ScannerModel
+	previousScanners, diags = toScanners(ctx, oldState.Asset.Scanners)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var plannedScannerIds []string
-	plannedScannerIds, diags = toStringArray(ctx, plannedState.Asset.Scanners)
+	var plannedScanners []boostsecurity.ScannerModel
+	plannedScanners, diags = toScanners(ctx, plannedState.Asset.Scanners)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plannedScannerIds := toScannerIds(plannedScanners)
 
 	// if previous scanner is not planned, we clear it
+	planned := make(map[string]bool)
+	for _, scannerId := range plannedScannerIds {
+		planned[scannerId] = true
+	}
 	toClear := make([]string, 0)
-	for _, scannerId := range previousSannerIds {
-		if !slices.Contains(plannedScannerIds, scannerId) {
+	for _, scannerId := range toScannerIds(previousScanners) {
+		if !planned[scannerId] {
 			toClear = append(toClear, scannerId)
 		}
 	}
 
-	asset, err := r.findInCache(&plannedState.Asset)
+	asset, err := r.findInCache(ctx, &plannedState.Asset)
 	if err != nil {
 		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 		return
@@ -273,22 +349,37 @@ func (r *scannerCoverageResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	if len(plannedState.Asset.Scanners.Elements()) > 0 {
+	diags = r.validateRulesets(ctx, plannedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A policy change is applied even when no scanner is planned or cleared.
+	if len(plannedScannerIds) > 0 || len(toClear) > 0 || !plannedState.Asset.Policy.Equal(oldState.Asset.Policy) {
 		assetType := boostsecurity.AssetTypeResource
 		if plannedState.Asset.Resource.IsNull() {
 			assetType = boostsecurity.AssetTypeCollection
 
 		}
 		err = r.client.ApplyPlan(ctx, asset.ID.ValueString(), assetType, plannedState.Asset.Policy.ValueString(), plannedScannerIds, toClear)
+		r.cache.invalidate(&plannedState.Asset)
 		if err != nil {
-			tflog.Debug(ctx, spew.Sdump(err))
-			resp.Diagnostics.AddError("Error applying update plan", "RIP : "+err.Error())
+			addOperationError(&resp.Diagnostics, "Error applying update plan", "Could not apply update plan on asset "+assetPath(&plannedState.Asset), err)
+			return
+		}
+
+		// The asset is read again, the assigned policy and rulesets reflect the applied plan.
+		asset, err = r.findInCache(ctx, &plannedState.Asset)
+		if err != nil {
+			resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
 			return
 		}
 	}
 
 	plannedState.Asset.ID = asset.ID
 	plannedState.Asset.AssignedPolicy = asset.Policy
+	plannedState.Asset.AssignedRulesets = asset.AssignedRulesets
 
 	diags = resp.State.Set(ctx, &plannedState)
 	resp.Diagnostics.Append(diags...)
@@ -312,50 +403,109 @@ func (r *scannerCoverageResource) Delete(ctx context.Context, req resource.Delet
 
 	}
 
-	var toClear []string
-	toClear, diags = toStringArray(ctx, state.Asset.Scanners)
+	var scanners []boostsecurity.ScannerModel
+	scanners, diags = toScanners(ctx, state.Asset.Scanners)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.ApplyPlan(ctx, state.Asset.ID.ValueString(), assetType, "", []string{}, toClear)
+	err := r.client.ApplyPlan(ctx, state.Asset.ID.ValueString(), assetType, "", []string{}, toScannerIds(scanners))
+	r.cache.invalidate(&state.Asset)
 	if err != nil {
-		tflog.Debug(ctx, spew.Sdump(err))
-		resp.Diagnostics.AddError("Error deleting plan", "RIP : "+err.Error())
+		addOperationError(&resp.Diagnostics, "Error deleting plan", "Could not clear plan on asset "+assetPath(&state.Asset), err)
 		return
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *scannerCoverageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ImportState imports an asset using an ID formatted as `<provider>/<collection>/<resource>`,
+// or `<provider>/<collection>` for a collection.
+func (r *scannerCoverageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "IMPORTING")
+	providerName, assetPath, found := strings.Cut(req.ID, "/")
+	if !found || providerName == "" || assetPath == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <provider>/<collection>/<resource> or <provider>/<collection>. Got: %q", req.ID),
+		)
 		return
 	}
 
-	client, ok := req.ProviderData.(*boostsecurity.Client)
+	// Collections may contain slashes, the last segment is either part of the collection or the resource name.
+	candidates := []boostsecurity.AssetModel{{
+		Provider:   types.StringValue(providerName),
+		Collection: types.StringValue(assetPath),
+		Resource:   types.StringNull(),
+	}}
+	if separator := strings.LastIndex(assetPath, "/"); separator != -1 {
+		candidates = append(candidates, boostsecurity.AssetModel{
+			Provider:   types.StringValue(providerName),
+			Collection: types.StringValue(assetPath[:separator]),
+			Resource:   types.StringValue(assetPath[separator+1:]),
+		})
+	}
+
+	matches := make([]boostsecurity.AssetModel, 0)
+	for _, candidate := range candidates {
+		_, err := r.cache.find(ctx, &candidate)
+		if errors.Is(err, errAssetNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
+			return
+		}
+		matches = append(matches, candidate)
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+req.ID+". Make sure the asset is managed by an integration")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Ambiguous import identifier", "Both a collection and a resource match : "+req.ID)
+		return
+	}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected GQL Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	asset, err := r.findInCache(ctx, &matches[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
+		return
+	}
+	provisioned, policyAssignment, err := r.findCachedAsset(ctx, &asset)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
+		return
+	}
+
+	asset.Scanners = refreshScanners(nil, provisioned)
+	asset.AssignedPolicy = asset.Policy
+	if policyAssignment != boostsecurity.PolicyAssignmentDirect {
+		asset.Policy = types.StringNull()
+	}
 
+	diags := resp.State.Set(ctx, &boostsecurity.State{Asset: asset})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scannerCoverageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
 		return
 	}
 
-	tflog.Debug(ctx, "Building cache")
-	posture, err := client.GetPosture(ctx)
-	if err != nil {
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected error getting posture",
-			fmt.Sprintf("While building cache, got: %T.", err),
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
-	r.cache = posture
+	r.client = data.client
+	r.cache = data.cache
+	r.failOnMissingAsset = data.failOnMissingAsset
 }
 
 func (r *scannerCoverageResource) validateScannerIds(ctx context.Context, state boostsecurity.State, assetId string) diag.Diagnostics {
@@ -374,80 +524,138 @@ func (r *scannerCoverageResource) validateScannerIds(ctx context.Context, state
 			return diags
 		}
 
-		var scannerIds []string
-		scannerIds, diags = toStringArray(ctx, state.Asset.Scanners)
+		var scanners []boostsecurity.ScannerModel
+		scanners, diags = toScanners(ctx, state.Asset.Scanners)
 		if diags.HasError() {
 			return diags
 		}
-		for _, scannerId := range scannerIds {
-			if !slices.Contains(availableScanners, scannerId) {
+		// the set only dedupes identical scanners, the same scanner with different rulesets is rejected
+		seen := make(map[string]bool)
+		for _, scanner := range scanners {
+			if scanner.ID.IsUnknown() {
+				continue
+			}
+			scannerId := scanner.ID.ValueString()
+			if seen[scannerId] {
+				diags.AddError("Duplicate scanner for asset", "Scanner is listed more than once : "+scannerId)
+				continue
+			}
+			seen[scannerId] = true
+			scannerIndex := slices.IndexFunc(availableScanners, availableScannerCompare(scannerId))
+			if scannerIndex == -1 {
 				diags.AddError("Scanner not available for asset", "Scanner not available for asset : "+scannerId)
+				continue
 			}
-		}
-	}
-	return diags
-}
 
-func (r *scannerCoverageResource) getAssetId(asset *boostsecurity.AssetModel) (string, error) {
-	if providerIndex := slices.IndexFunc(r.cache.Providers, providerCompare(asset.Provider)); providerIndex != -1 {
-		provider := r.cache.Providers[providerIndex]
-		if collectionIndex := slices.IndexFunc(provider.Organizations, collectionCompare(asset.Collection)); collectionIndex != -1 {
-			collection := provider.Organizations[collectionIndex]
-			if asset.Resource.IsNull() {
-				return collection.ID, nil
+			availableScanner := availableScanners[scannerIndex]
+			if scanner.Ruleset.IsNull() {
+				if availableScanner.RulesetRequired {
+					diags.AddError("Ruleset required for scanner", "Scanner requires a ruleset : "+scannerId+". Available rulesets : "+strings.Join(availableScanner.Rulesets, ", "))
+				}
+				continue
 			}
-			if resourceIndex := slices.IndexFunc(collection.Resources, resourceCompare(asset.Resource)); resourceIndex != -1 {
-				rcs := collection.Resources[resourceIndex]
-				return rcs.ID, nil
+			if !scanner.Ruleset.IsUnknown() && !slices.Contains(availableScanner.Rulesets, scanner.Ruleset.ValueString()) {
+				diags.AddError("Ruleset not available for scanner", "Ruleset "+scanner.Ruleset.ValueString()+" not available for scanner : "+scannerId+". Available rulesets : "+strings.Join(availableScanner.Rulesets, ", "))
 			}
 		}
 	}
-
-	return "", errors.New("could not find asset id. Make sure the asset is managed by an integration")
+	return diags
 }
 
-func (r *scannerCoverageResource) findInCache(asset *boostsecurity.AssetModel) (boostsecurity.AssetModel, error) {
-	if providerIndex := slices.IndexFunc(r.cache.Providers, providerCompare(asset.Provider)); providerIndex != -1 {
-		provider := r.cache.Providers[providerIndex]
-		if collectionIndex := slices.IndexFunc(provider.Organizations, collectionCompare(asset.Collection)); collectionIndex != -1 {
-			collection := provider.Organizations[collectionIndex]
-			if asset.Resource.IsNull() {
-				scanners := make([]attr.Value, 0)
-				for _, scanner := range collection.Scanners {
-					scanners = append(scanners, types.StringValue(scanner))
-				}
-				return boostsecurity.AssetModel{
-					Provider:   types.StringValue(provider.Name),
-					Collection: types.StringValue(collection.Name),
-					Resource:   types.StringNull(),
-					ID:         types.StringValue(collection.ID),
-					Scanners:   types.ListValueMust(types.StringType, scanners),
-					Policy:     types.StringValue(collection.Policy),
-				}, nil
-			}
-			if resourceIndex := slices.IndexFunc(collection.Resources, resourceCompare(asset.Resource)); resourceIndex != -1 {
-				rcs := collection.Resources[resourceIndex]
-				scanners := make([]attr.Value, 0)
+// validateRulesets checks that the configured rulesets match the ones active on the asset. The provision plan
+// only takes scanner IDs, so a ruleset cannot be applied and may only assert the one chosen in Boost.
+func (r *scannerCoverageResource) validateRulesets(ctx context.Context, state boostsecurity.State) diag.Diagnostics {
+	scanners, diags := toScanners(ctx, state.Asset.Scanners)
+	if diags.HasError() {
+		return diags
+	}
 
-				for _, scanner := range rcs.Scanners {
-					scanners = append(scanners, types.StringValue(scanner))
-				}
-				return boostsecurity.AssetModel{
-					Provider:   types.StringValue(provider.Name),
-					Collection: types.StringValue(collection.Name),
-					Resource:   types.StringValue(rcs.Name),
-					ID:         types.StringValue(rcs.ID),
-					Scanners:   types.ListValueMust(types.StringType, scanners),
-					Policy:     types.StringValue(collection.Policy),
-				}, nil
+	var provisioned []boostsecurity.ProvisionedScannerModel
+	for _, scanner := range scanners {
+		if scanner.Ruleset.IsNull() || scanner.Ruleset.IsUnknown() {
+			continue
+		}
+		if provisioned == nil {
+			var err error
+			provisioned, _, err = r.findCachedAsset(ctx, &state.Asset)
+			if err != nil {
+				diags.AddError("Error finding asset in cache", "Could not find asset : "+err.Error())
+				return diags
 			}
 		}
+
+		scannerId := scanner.ID.ValueString()
+		active := "none"
+		if index := slices.IndexFunc(provisioned, provisionedScannerCompare(scannerId)); index != -1 && provisioned[index].Ruleset != "" {
+			active = provisioned[index].Ruleset
+		}
+		if active != scanner.Ruleset.ValueString() {
+			diags.AddError(
+				"Ruleset cannot be applied",
+				"Ruleset "+scanner.Ruleset.ValueString()+" cannot be applied to scanner "+scannerId+", the active ruleset is "+active+". "+
+					"Rulesets are selected in Boost, remove the ruleset or set it to the active one.",
+			)
+		}
 	}
+	return diags
+}
+
+func (r *scannerCoverageResource) getAssetId(ctx context.Context, asset *boostsecurity.AssetModel) (string, error) {
+	found, err := r.cache.find(ctx, asset)
+	if errors.Is(err, errAssetNotFound) {
+		return "", errors.New("could not find asset id. Make sure the asset is managed by an integration")
+	}
+	if err != nil {
+		return "", err
+	}
+	if found.resource != nil {
+		return found.resource.ID, nil
+	}
+
+	return found.collection.ID, nil
+}
 
-	return boostsecurity.AssetModel{}, errors.New("could not find asset. Make sure the asset is managed by an integration")
+func (r *scannerCoverageResource) findInCache(ctx context.Context, asset *boostsecurity.AssetModel) (boostsecurity.AssetModel, error) {
+	found, err := r.cache.find(ctx, asset)
+	if err != nil {
+		return boostsecurity.AssetModel{}, err
+	}
+
+	collection := found.collection
+	if found.resource == nil {
+		scanners, rulesets := toScannerValues(collection.Scanners)
+		return boostsecurity.AssetModel{
+			Provider:         types.StringValue(found.provider),
+			Collection:       types.StringValue(collection.Name),
+			Resource:         types.StringNull(),
+			ID:               types.StringValue(collection.ID),
+			Scanners:         scanners,
+			Policy:           types.StringValue(collection.Policy),
+			AssignedRulesets: rulesets,
+		}, nil
+	}
+
+	// A resource reports its own policy, which differs from the policy of its collection when assigned directly.
+	rcs := found.resource
+	scanners, rulesets := toScannerValues(rcs.Scanners)
+	return boostsecurity.AssetModel{
+		Provider:         types.StringValue(found.provider),
+		Collection:       types.StringValue(collection.Name),
+		Resource:         types.StringValue(rcs.Name),
+		ID:               types.StringValue(rcs.ID),
+		Scanners:         scanners,
+		Policy:           types.StringValue(rcs.Policy),
+		AssignedRulesets: rulesets,
+	}, nil
+}
+
+// elementsValue is implemented by list and set values.
+type elementsValue interface {
+	Elements() []attr.Value
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
 }
 
-func toStringArray(ctx context.Context, in types.List) ([]string, diag.Diagnostics) {
+func toStringArray(ctx context.Context, in elementsValue) ([]string, diag.Diagnostics) {
 	scannerIds := make([]string, 0)
 	var diags diag.Diagnostics
 	if len(in.Elements()) > 0 {
@@ -461,18 +669,110 @@ func toStringArray(ctx context.Context, in types.List) ([]string, diag.Diagnosti
 	return scannerIds, diags
 }
 
-func providerCompare(value types.String) func(model boostsecurity.ProviderModel) bool {
-	return func(model boostsecurity.ProviderModel) bool {
-		return model.Name == value.ValueString()
+// findCachedAsset returns the scanners provisioned on the asset and how its policy is assigned.
+func (r *scannerCoverageResource) findCachedAsset(ctx context.Context, asset *boostsecurity.AssetModel) ([]boostsecurity.ProvisionedScannerModel, boostsecurity.PolicyAssignment, error) {
+	found, err := r.cache.find(ctx, asset)
+	if err != nil {
+		return nil, "", err
+	}
+	if found.resource != nil {
+		return found.resource.Scanners, found.resource.PolicyAssignment, nil
+	}
+
+	return found.collection.Scanners, found.collection.PolicyAssignment, nil
+}
+
+// refreshScanners builds the scanners of the state from the scanners provisioned on the asset.
+// Scanners in the prior state with a ruleset get the active one, removed ones are dropped. Scanners provisioned
+// out-of-band are added when managed by Boost, but MANUAL scanners are left alone unless already in the state
+// since they are installed outside of Boost and terraform cannot own them.
+func refreshScanners(priorScanners []boostsecurity.ScannerModel, provisioned []boostsecurity.ProvisionedScannerModel) types.Set {
+	scanners := make([]attr.Value, 0)
+	for _, scanner := range priorScanners {
+		index := slices.IndexFunc(provisioned, provisionedScannerCompare(scanner.ID.ValueString()))
+		if index == -1 {
+			continue
+		}
+		ruleset := scanner.Ruleset
+		if !ruleset.IsNull() {
+			ruleset = optionalString(provisioned[index].Ruleset)
+		}
+		scanners = append(scanners, types.ObjectValueMust(scannerAttrTypes, map[string]attr.Value{
+			"id":      scanner.ID,
+			"ruleset": ruleset,
+		}))
+	}
+	for _, scanner := range provisioned {
+		if scanner.ProvisioningMethod == boostsecurity.ProvisioningMethodManual {
+			continue
+		}
+		if slices.IndexFunc(priorScanners, func(model boostsecurity.ScannerModel) bool { return model.ID.ValueString() == scanner.ID }) != -1 {
+			continue
+		}
+		scanners = append(scanners, types.ObjectValueMust(scannerAttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(scanner.ID),
+			"ruleset": types.StringNull(),
+		}))
 	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, scanners)
+}
+
+// assetPath formats an asset as `<provider>/<collection>/<resource>`, as used by import.
+func assetPath(asset *boostsecurity.AssetModel) string {
+	path := asset.Provider.ValueString() + "/" + asset.Collection.ValueString()
+	if !asset.Resource.IsNull() {
+		path += "/" + asset.Resource.ValueString()
+	}
+
+	return path
 }
-func collectionCompare(value types.String) func(model boostsecurity.OrganizationModel) bool {
-	return func(model boostsecurity.OrganizationModel) bool {
-		return model.Name == value.ValueString()
+
+func toScanners(ctx context.Context, in types.Set) ([]boostsecurity.ScannerModel, diag.Diagnostics) {
+	scanners := make([]boostsecurity.ScannerModel, 0)
+	var diags diag.Diagnostics
+	if len(in.Elements()) > 0 {
+		diags = in.ElementsAs(ctx, &scanners, false)
+	}
+
+	return scanners, diags
+}
+
+func toScannerIds(scanners []boostsecurity.ScannerModel) []string {
+	scannerIds := make([]string, 0)
+	for _, scanner := range scanners {
+		scannerIds = append(scannerIds, scanner.ID.ValueString())
+	}
+
+	return scannerIds
+}
+
+// toScannerValues converts provisioned scanners to the scanners set and the assigned rulesets map of the state.
+func toScannerValues(provisioned []boostsecurity.ProvisionedScannerModel) (types.Set, types.Map) {
+	scanners := make([]attr.Value, 0)
+	rulesets := make(map[string]attr.Value)
+	for _, scanner := range provisioned {
+		ruleset := types.StringNull()
+		if scanner.Ruleset != "" {
+			ruleset = types.StringValue(scanner.Ruleset)
+			rulesets[scanner.ID] = ruleset
+		}
+		scanners = append(scanners, types.ObjectValueMust(scannerAttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(scanner.ID),
+			"ruleset": ruleset,
+		}))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, scanners), types.MapValueMust(types.StringType, rulesets)
+}
+
+func provisionedScannerCompare(value string) func(model boostsecurity.ProvisionedScannerModel) bool {
+	return func(model boostsecurity.ProvisionedScannerModel) bool {
+		return model.ID == value
 	}
 }
-func resourceCompare(value types.String) func(model boostsecurity.ResourcesModel) bool {
-	return func(model boostsecurity.ResourcesModel) bool {
-		return model.Name == value.ValueString()
+func availableScannerCompare(value string) func(model boostsecurity.ProvisionPlanScannerModel) bool {
+	return func(model boostsecurity.ProvisionPlanScannerModel) bool {
+		return model.ID == value
 	}
 }
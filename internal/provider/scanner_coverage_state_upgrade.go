@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// stateV0 maps the version 0 of the schema, where scanners were a list of scanner IDs.
+type stateV0 struct {
+	Asset assetModelV0 `tfsdk:"asset"`
+}
+
+type assetModelV0 struct {
+	Provider       types.String `tfsdk:"provider"`
+	Collection     types.String `tfsdk:"collection"`
+	Resource       types.String `tfsdk:"resource"`
+	ID             types.String `tfsdk:"id"`
+	Scanners       types.List   `tfsdk:"scanners"`
+	Policy         types.String `tfsdk:"policy"`
+	AssignedPolicy types.String `tfsdk:"assigned_policy"`
+}
+
+// stateV1 maps the version 1 of the schema, where scanners were a list of scanner objects.
+type stateV1 struct {
+	Asset assetModelV1 `tfsdk:"asset"`
+}
+
+type assetModelV1 struct {
+	Provider         types.String `tfsdk:"provider"`
+	Collection       types.String `tfsdk:"collection"`
+	Resource         types.String `tfsdk:"resource"`
+	ID               types.String `tfsdk:"id"`
+	Scanners         types.List   `tfsdk:"scanners"`
+	Policy           types.String `tfsdk:"policy"`
+	AssignedPolicy   types.String `tfsdk:"assigned_policy"`
+	AssignedRulesets types.Map    `tfsdk:"assigned_rulesets"`
+}
+
+// UpgradeState upgrades the state stored by previous versions of the schema.
+func (r *scannerCoverageResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"asset": schema.SingleNestedAttribute{
+						Required: true,
+						Attributes: map[string]schema.Attribute{
+							"provider":   schema.StringAttribute{Required: true},
+							"collection": schema.StringAttribute{Required: true},
+							"resource":   schema.StringAttribute{Optional: true},
+							"id":         schema.StringAttribute{Computed: true},
+							"scanners": schema.ListAttribute{
+								ElementType: types.StringType,
+								Required:    true,
+							},
+							"policy":          schema.StringAttribute{Optional: true},
+							"assigned_policy": schema.StringAttribute{Computed: true},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+		1: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"asset": schema.SingleNestedAttribute{
+						Required: true,
+						Attributes: map[string]schema.Attribute{
+							"provider":   schema.StringAttribute{Required: true},
+							"collection": schema.StringAttribute{Required: true},
+							"resource":   schema.StringAttribute{Optional: true},
+							"id":         schema.StringAttribute{Computed: true},
+							"scanners": schema.ListNestedAttribute{
+								Required: true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"id":      schema.StringAttribute{Required: true},
+										"ruleset": schema.StringAttribute{Optional: true},
+									},
+								},
+							},
+							"policy":          schema.StringAttribute{Optional: true},
+							"assigned_policy": schema.StringAttribute{Computed: true},
+							"assigned_rulesets": schema.MapAttribute{
+								ElementType: types.StringType,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeStateV1,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorState stateV0
+	diags := req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scannerIds []string
+	scannerIds, diags = toStringArray(ctx, priorState.Asset.Scanners)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scanners := make([]attr.Value, 0)
+	for _, scannerId := range scannerIds {
+		scanners = append(scanners, types.ObjectValueMust(scannerAttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(scannerId),
+			"ruleset": types.StringNull(),
+		}))
+	}
+
+	state := boostsecurity.State{
+		Asset: boostsecurity.AssetModel{
+			Provider:         priorState.Asset.Provider,
+			Collection:       priorState.Asset.Collection,
+			Resource:         priorState.Asset.Resource,
+			ID:               priorState.Asset.ID,
+			Scanners:         types.SetValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, scanners),
+			Policy:           priorState.Asset.Policy,
+			AssignedPolicy:   priorState.Asset.AssignedPolicy,
+			AssignedRulesets: types.MapNull(types.StringType),
+		},
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func upgradeStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorState stateV1
+	diags := req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := boostsecurity.State{
+		Asset: boostsecurity.AssetModel{
+			Provider:         priorState.Asset.Provider,
+			Collection:       priorState.Asset.Collection,
+			Resource:         priorState.Asset.Resource,
+			ID:               priorState.Asset.ID,
+			Scanners:         types.SetValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, priorState.Asset.Scanners.Elements()),
+			Policy:           priorState.Asset.Policy,
+			AssignedPolicy:   priorState.Asset.AssignedPolicy,
+			AssignedRulesets: priorState.Asset.AssignedRulesets,
+		},
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+	"testing"
+)
+
+// upgradeState runs the upgrader of version on prior, a value of the matching state model.
+func upgradeState(t *testing.T, version int64, prior any) boostsecurity.State {
+	ctx := context.Background()
+	r := &scannerCoverageResource{}
+	upgrader := r.UpgradeState(ctx)[version]
+
+	priorState := tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil)}
+	if diags := priorState.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting the prior state: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &priorState}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics upgrading the state: %v", resp.Diagnostics)
+	}
+
+	var state boostsecurity.State
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading the upgraded state: %v", diags)
+	}
+	return state
+}
+
+func scannerValue(id string, ruleset types.String) attr.Value {
+	return types.ObjectValueMust(scannerAttrTypes, map[string]attr.Value{"id": types.StringValue(id), "ruleset": ruleset})
+}
+
+func scannerSet(scanners ...attr.Value) types.Set {
+	return types.SetValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, scanners)
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	state := upgradeState(t, 0, &stateV0{Asset: assetModelV0{
+		Provider:       types.StringValue("github"),
+		Collection:     types.StringValue("boost"),
+		Resource:       types.StringNull(),
+		ID:             types.StringValue("collection-id"),
+		Scanners:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("scanner-a"), types.StringValue("scanner-b")}),
+		Policy:         types.StringValue("policy-id"),
+		AssignedPolicy: types.StringValue("policy-id"),
+	}})
+
+	want := boostsecurity.AssetModel{
+		Provider:         types.StringValue("github"),
+		Collection:       types.StringValue("boost"),
+		Resource:         types.StringNull(),
+		ID:               types.StringValue("collection-id"),
+		Scanners:         scannerSet(scannerValue("scanner-a", types.StringNull()), scannerValue("scanner-b", types.StringNull())),
+		Policy:           types.StringValue("policy-id"),
+		AssignedPolicy:   types.StringValue("policy-id"),
+		AssignedRulesets: types.MapNull(types.StringType),
+	}
+	assertAsset(t, state.Asset, want)
+}
+
+func TestUpgradeStateV1(t *testing.T) {
+	rulesets := types.MapValueMust(types.StringType, map[string]attr.Value{"scanner-a": types.StringValue("strict")})
+	state := upgradeState(t, 1, &stateV1{Asset: assetModelV1{
+		Provider:   types.StringValue("github"),
+		Collection: types.StringValue("boost"),
+		Resource:   types.StringValue("api"),
+		ID:         types.StringValue("resource-id"),
+		Scanners: types.ListValueMust(types.ObjectType{AttrTypes: scannerAttrTypes}, []attr.Value{
+			scannerValue("scanner-a", types.StringValue("strict")),
+			scannerValue("scanner-b", types.StringNull()),
+		}),
+		Policy:           types.StringNull(),
+		AssignedPolicy:   types.StringValue("policy-id"),
+		AssignedRulesets: rulesets,
+	}})
+
+	want := boostsecurity.AssetModel{
+		Provider:         types.StringValue("github"),
+		Collection:       types.StringValue("boost"),
+		Resource:         types.StringValue("api"),
+		ID:               types.StringValue("resource-id"),
+		Scanners:         scannerSet(scannerValue("scanner-a", types.StringValue("strict")), scannerValue("scanner-b", types.StringNull())),
+		Policy:           types.StringNull(),
+		AssignedPolicy:   types.StringValue("policy-id"),
+		AssignedRulesets: rulesets,
+	}
+	assertAsset(t, state.Asset, want)
+}
+
+func assertAsset(t *testing.T, got boostsecurity.AssetModel, want boostsecurity.AssetModel) {
+	values := []struct {
+		name      string
+		got, want attr.Value
+	}{
+		{"provider", got.Provider, want.Provider},
+		{"collection", got.Collection, want.Collection},
+		{"resource", got.Resource, want.Resource},
+		{"id", got.ID, want.ID},
+		{"scanners", got.Scanners, want.Scanners},
+		{"policy", got.Policy, want.Policy},
+		{"assigned_policy", got.AssignedPolicy, want.AssignedPolicy},
+		{"assigned_rulesets", got.AssignedRulesets, want.AssignedRulesets},
+	}
+	for _, value := range values {
+		if !value.got.Equal(value.want) {
+			t.Errorf("%s: got %s, want %s", value.name, value.got, value.want)
+		}
+	}
+}
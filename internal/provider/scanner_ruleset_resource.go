@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+	"strings"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &scannerRulesetResource{}
+	_ resource.ResourceWithConfigure   = &scannerRulesetResource{}
+	_ resource.ResourceWithImportState = &scannerRulesetResource{}
+)
+
+// NewScannerRulesetResource is a helper function to simplify the provider implementation.
+func NewScannerRulesetResource() resource.Resource {
+	return &scannerRulesetResource{}
+}
+
+// scannerRulesetResource is the resource implementation.
+type scannerRulesetResource struct {
+	client *boostsecurity.Client
+}
+
+// Metadata returns the resource type name.
+func (r *scannerRulesetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scanner_ruleset"
+}
+
+// Schema defines the schema for the resource.
+func (r *scannerRulesetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named ruleset of a scanner.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The UUID of the ruleset, assigned by the server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"analyzer_id": schema.StringAttribute{
+				Description: "The ID of the analyzer owning the ruleset.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the ruleset, unique for the analyzer.",
+				Required:    true,
+			},
+			"configs": schema.MapAttribute{
+				Description: "The configuration of the ruleset, as key/value mappings.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Whether the ruleset is used by a provisioned scanner.",
+				Computed:    true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description:         "Allow the ruleset to be destroyed while it is in use.",
+				MarkdownDescription: "Allow the ruleset to be destroyed while it is in use. \n Defaults to `false`, destroying a ruleset that is `in_use` fails.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *scannerRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATING")
+	var state boostsecurity.ScannerRulesetState
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configs := make(map[string]string)
+	diags = state.Configs.ElementsAs(ctx, &configs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	config, err := r.client.UpdateScannerConfig(ctx, state.AnalyzerID.ValueString(), func(config *boostsecurity.ScannerConfigModel) error {
+		if slices.IndexFunc(config.Rulesets, rulesetNameCompare(name)) != -1 {
+			return fmt.Errorf("a ruleset named %q already exists", name)
+		}
+		config.Rulesets = append(config.Rulesets, boostsecurity.ScannerRulesetModel{Name: name, Configs: configs})
+		return nil
+	})
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error creating scanner ruleset", "Could not create scanner ruleset "+name, err)
+		return
+	}
+
+	rulesetIndex := slices.IndexFunc(config.Rulesets, rulesetNameCompare(name))
+	if rulesetIndex == -1 {
+		resp.Diagnostics.AddError("Error creating scanner ruleset", "Ruleset not returned by the server : "+name)
+		return
+	}
+
+	diags = setScannerRulesetState(&state, config.Rulesets[rulesetIndex])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *scannerRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READING")
+	var state boostsecurity.ScannerRulesetState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesetId, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ruleset ID", "Ruleset ID is not a UUID : "+err.Error())
+		return
+	}
+
+	config, err := r.client.GetScannerConfig(ctx, state.AnalyzerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scanner ruleset", "Could not read scanner config : "+err.Error())
+		return
+	}
+
+	rulesetIndex := -1
+	if config != nil {
+		rulesetIndex = slices.IndexFunc(config.Rulesets, rulesetIdCompare(rulesetId))
+	}
+	if rulesetIndex == -1 {
+		tflog.Debug(ctx, "Scanner ruleset not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = setScannerRulesetState(&state, config.Rulesets[rulesetIndex])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *scannerRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedState boostsecurity.ScannerRulesetState
+	diags := req.Plan.Get(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesetId, err := uuid.Parse(plannedState.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ruleset ID", "Ruleset ID is not a UUID : "+err.Error())
+		return
+	}
+
+	configs := make(map[string]string)
+	diags = plannedState.Configs.ElementsAs(ctx, &configs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plannedState.Name.ValueString()
+	config, err := r.client.UpdateScannerConfig(ctx, plannedState.AnalyzerID.ValueString(), func(config *boostsecurity.ScannerConfigModel) error {
+		rulesetIndex := slices.IndexFunc(config.Rulesets, rulesetIdCompare(rulesetId))
+		if rulesetIndex == -1 {
+			return errors.New("ruleset no longer exists : " + rulesetId.String())
+		}
+		if nameIndex := slices.IndexFunc(config.Rulesets, rulesetNameCompare(name)); nameIndex != -1 && nameIndex != rulesetIndex {
+			return fmt.Errorf("a ruleset named %q already exists", name)
+		}
+		config.Rulesets[rulesetIndex].Name = name
+		config.Rulesets[rulesetIndex].Configs = configs
+		return nil
+	})
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error updating scanner ruleset", "Could not update scanner ruleset "+rulesetId.String(), err)
+		return
+	}
+
+	rulesetIndex := slices.IndexFunc(config.Rulesets, rulesetIdCompare(rulesetId))
+	if rulesetIndex == -1 {
+		resp.Diagnostics.AddError("Error updating scanner ruleset", "Ruleset not returned by the server : "+rulesetId.String())
+		return
+	}
+
+	diags = setScannerRulesetState(&plannedState, config.Rulesets[rulesetIndex])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plannedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *scannerRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state boostsecurity.ScannerRulesetState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesetId, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ruleset ID", "Ruleset ID is not a UUID : "+err.Error())
+		return
+	}
+
+	forceDestroy := state.ForceDestroy.ValueBool()
+	_, err = r.client.UpdateScannerConfig(ctx, state.AnalyzerID.ValueString(), func(config *boostsecurity.ScannerConfigModel) error {
+		rulesetIndex := slices.IndexFunc(config.Rulesets, rulesetIdCompare(rulesetId))
+		if rulesetIndex == -1 {
+			// Already deleted, or the analyzer has no configuration anymore.
+			return boostsecurity.ErrScannerConfigUnchanged
+		}
+		if config.Rulesets[rulesetIndex].InUse && !forceDestroy {
+			return errors.New("ruleset is in use, set force_destroy to destroy it anyway")
+		}
+		config.Rulesets = slices.Delete(config.Rulesets, rulesetIndex, rulesetIndex+1)
+		return nil
+	})
+	if err != nil {
+		addOperationError(&resp.Diagnostics, "Error deleting scanner ruleset", "Could not delete scanner ruleset "+rulesetId.String(), err)
+		return
+	}
+}
+
+// ImportState imports a ruleset using an ID formatted as `<analyzer_id>/<ruleset_id>`.
+func (r *scannerRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	separator := strings.LastIndex(req.ID, "/")
+	if separator <= 0 || separator == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <analyzer_id>/<ruleset_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("analyzer_id"), req.ID[:separator])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[separator+1:])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scannerRulesetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func setScannerRulesetState(state *boostsecurity.ScannerRulesetState, ruleset boostsecurity.ScannerRulesetModel) diag.Diagnostics {
+	configs, diags := toMapValue(ruleset.Configs)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.ID = types.StringValue(ruleset.ID.String())
+	state.Name = types.StringValue(ruleset.Name)
+	state.Configs = configs
+	state.InUse = types.BoolValue(ruleset.InUse)
+
+	return diags
+}
+
+func rulesetIdCompare(value uuid.UUID) func(model boostsecurity.ScannerRulesetModel) bool {
+	return func(model boostsecurity.ScannerRulesetModel) bool {
+		return model.ID == value
+	}
+}
+func rulesetNameCompare(value string) func(model boostsecurity.ScannerRulesetModel) bool {
+	return func(model boostsecurity.ScannerRulesetModel) bool {
+		return model.Name == value
+	}
+}
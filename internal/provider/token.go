@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"os"
+	"strings"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// tokenSource resolves the source of the API token. At most one of token, token_file and token_command can be
+// configured, the BOOST_TOKEN and BOOST_TOKEN_FILE environment variables are used when none is.
+func tokenSource(ctx context.Context, config *boostsecurityProviderModel, diags *diag.Diagnostics) boostsecurity.TokenSource {
+	configured := make([]string, 0)
+	if !config.Token.IsNull() {
+		configured = append(configured, "token")
+	}
+	if !config.TokenFile.IsNull() {
+		configured = append(configured, "token_file")
+	}
+	if !config.TokenCommand.IsNull() {
+		configured = append(configured, "token_command")
+	}
+	if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting Boost API Token Sources",
+			"Only one of token, token_file and token_command can be set, got: "+strings.Join(configured, ", ")+".",
+		)
+		return nil
+	}
+
+	var source boostsecurity.TokenSource
+	switch {
+	case !config.Token.IsNull():
+		source = boostsecurity.StaticToken(config.Token.ValueString())
+	case !config.TokenFile.IsNull():
+		source = boostsecurity.FileTokenSource(config.TokenFile.ValueString())
+	case !config.TokenCommand.IsNull():
+		command, elementDiags := toStringArray(ctx, config.TokenCommand)
+		diags.Append(elementDiags...)
+		if len(command) == 0 || command[0] == "" {
+			diags.AddAttributeError(
+				path.Root("token_command"),
+				"Invalid Boost API Token Command",
+				"The token command must contain at least the executable to run.",
+			)
+			return nil
+		}
+		source = boostsecurity.CommandTokenSource(command)
+	case os.Getenv("BOOST_TOKEN") != "" && os.Getenv("BOOST_TOKEN_FILE") != "":
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Conflicting Boost API Token Sources",
+			"Only one of the BOOST_TOKEN and BOOST_TOKEN_FILE environment variables can be set.",
+		)
+		return nil
+	case os.Getenv("BOOST_TOKEN") != "":
+		source = boostsecurity.StaticToken(os.Getenv("BOOST_TOKEN"))
+	case os.Getenv("BOOST_TOKEN_FILE") != "":
+		source = boostsecurity.FileTokenSource(os.Getenv("BOOST_TOKEN_FILE"))
+	}
+
+	if source == nil {
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Missing Boost API Token",
+			"The provider cannot create the Boost API client as there is a missing or empty value for the Boost API token. "+
+				"Set one of token, token_file or token_command in the configuration, or use the BOOST_TOKEN or BOOST_TOKEN_FILE environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+		return nil
+	}
+
+	// The token is fetched right away so that a broken source is reported here rather than by the first request.
+	if _, err := source.Token(ctx); err != nil {
+		diags.AddAttributeError(
+			path.Root(sourceAttribute(configured)),
+			"Unable to Read Boost API Token",
+			"The provider cannot create the Boost API client as the Boost API token could not be read : "+err.Error(),
+		)
+		return nil
+	}
+
+	return source
+}
+
+func sourceAttribute(configured []string) string {
+	if len(configured) == 1 {
+		return configured[0]
+	}
+
+	return "token"
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"os"
+	"strconv"
+	"terraform-provider-boostsecurity/internal/boostsecurity"
+)
+
+// transportConfig resolves the HTTP transport settings, each attribute falling back to its environment variable.
+func transportConfig(config *boostsecurityProviderModel, diags *diag.Diagnostics) boostsecurity.TransportConfig {
+	transport := boostsecurity.TransportConfig{
+		Timeout:  parseDuration(withEnv(config.RequestTimeout, "BOOST_REQUEST_TIMEOUT"), path.Root("request_timeout"), boostsecurity.DefaultRequestTimeout, diags),
+		ProxyURL: withEnv(config.ProxyURL, "BOOST_PROXY_URL").ValueString(),
+	}
+
+	caCertFile := withEnv(config.CACertFile, "BOOST_CA_CERT_FILE")
+	caCertPEM := withEnv(config.CACertPEM, "BOOST_CA_CERT_PEM")
+	if !caCertFile.IsNull() && !caCertPEM.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting Boost API CA Certificate",
+			"Only one of ca_cert_file and ca_cert_pem can be set, including through the BOOST_CA_CERT_FILE and BOOST_CA_CERT_PEM environment variables.",
+		)
+	} else if !caCertPEM.IsNull() {
+		transport.CACertPEM = []byte(caCertPEM.ValueString())
+	} else if !caCertFile.IsNull() {
+		transport.CACertPEM = readFile(caCertFile.ValueString(), path.Root("ca_cert_file"), diags)
+	}
+
+	clientCert := withEnv(config.ClientCert, "BOOST_CLIENT_CERT")
+	clientKey := withEnv(config.ClientKey, "BOOST_CLIENT_KEY")
+	if clientCert.IsNull() != clientKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Boost API Client Certificate",
+			"Both client_cert and client_key must be set to authenticate with a client certificate.",
+		)
+	} else if !clientCert.IsNull() {
+		transport.ClientCertPEM = readFile(clientCert.ValueString(), path.Root("client_cert"), diags)
+		transport.ClientKeyPEM = readFile(clientKey.ValueString(), path.Root("client_key"), diags)
+	}
+
+	transport.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	if config.InsecureSkipVerify.IsNull() {
+		if value := os.Getenv("BOOST_INSECURE_SKIP_VERIFY"); value != "" {
+			insecureSkipVerify, err := strconv.ParseBool(value)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("insecure_skip_verify"),
+					"Invalid Boost API Insecure Skip Verify",
+					fmt.Sprintf("The BOOST_INSECURE_SKIP_VERIFY environment variable must be a boolean, got: %q.", value),
+				)
+			}
+			transport.InsecureSkipVerify = insecureSkipVerify
+		}
+	}
+
+	return transport
+}
+
+// withEnv returns value, or the environment variable env when value is not set.
+func withEnv(value types.String, env string) types.String {
+	if !value.IsNull() {
+		return value
+	}
+	if envValue := os.Getenv(env); envValue != "" {
+		return types.StringValue(envValue)
+	}
+
+	return types.StringNull()
+}
+
+func readFile(name string, attributePath path.Path, diags *diag.Diagnostics) []byte {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Unable to Read Boost API Certificate",
+			fmt.Sprintf("Could not read %s : %s", name, err.Error()),
+		)
+	}
+
+	return content
+}